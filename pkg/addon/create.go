@@ -0,0 +1,80 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// CreateOrAdopt creates the addon, unless one with the same provider and name already exists.
+// The existing addon is returned when adoption is allowed and it matches the requested plan and region,
+// an error is raised otherwise.
+// It prevents duplicates when a creation succeeded on the API side but not on the client one.
+func CreateOrAdopt(ctx context.Context, cc *client.Client, organisation string, req tmp.AddonRequest, adopt bool) (*tmp.AddonResponse, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	addonsRes := tmp.ListAddons(ctx, cc, organisation)
+	if addonsRes.HasError() {
+		diags.AddError("failed to list addons", addonsRes.Error().Error())
+		return nil, diags
+	}
+
+	existing := pkg.First(*addonsRes.Payload(), func(addon tmp.AddonResponse) bool {
+		return addon.Provider.ID == req.ProviderID && addon.Name == req.Name
+	})
+	if existing != nil {
+		if !adopt {
+			diags.AddAttributeError(
+				path.Root("name"),
+				"addon already exists",
+				fmt.Sprintf("a %s addon named '%s' already exists (%s), set adopt_existing = true to manage it or import it", req.ProviderID, req.Name, existing.ID),
+			)
+			return nil, diags
+		}
+
+		diags.Append(checkAdopted(existing, req)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		tflog.Info(ctx, "adopting existing addon", map[string]interface{}{"id": existing.ID, "name": existing.Name})
+		return existing, diags
+	}
+
+	res := tmp.CreateAddon(ctx, cc, organisation, req)
+	if res.HasError() {
+		diags.AddError("failed to create addon", res.Error().Error())
+		return nil, diags
+	}
+
+	return res.Payload(), diags
+}
+
+// an adopted addon is only managed as is, it is not moved nor migrated behind the user back
+func checkAdopted(existing *tmp.AddonResponse, req tmp.AddonRequest) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if existing.Region != req.Region {
+		diags.AddAttributeError(
+			path.Root("region"),
+			"existing addon region differs",
+			fmt.Sprintf("the %s addon named '%s' (%s) is in region '%s', not '%s'", req.ProviderID, req.Name, existing.ID, existing.Region, req.Region),
+		)
+	}
+
+	if existing.Plan.ID != req.Plan {
+		diags.AddAttributeError(
+			path.Root("plan"),
+			"existing addon plan differs",
+			fmt.Sprintf("the %s addon named '%s' (%s) uses plan '%s', set it in the configuration to adopt it", req.ProviderID, req.Name, existing.ID, existing.Plan.Slug),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,36 @@
+package addon
+
+import (
+	"testing"
+
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+func TestCheckAdopted(t *testing.T) {
+	existing := &tmp.AddonResponse{
+		ID:     "addon_1",
+		Name:   "my-db",
+		Region: "par",
+		Plan:   tmp.AddonPlan{ID: "plan_xs", Slug: "xs_sml"},
+	}
+
+	tests := []struct {
+		name   string
+		req    tmp.AddonRequest
+		errors int
+	}{
+		{"same plan and region", tmp.AddonRequest{Name: "my-db", Plan: "plan_xs", Region: "par"}, 0},
+		{"other region", tmp.AddonRequest{Name: "my-db", Plan: "plan_xs", Region: "rbx"}, 1},
+		{"other plan", tmp.AddonRequest{Name: "my-db", Plan: "plan_m", Region: "par"}, 1},
+		{"other plan and region", tmp.AddonRequest{Name: "my-db", Plan: "plan_m", Region: "rbx"}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := checkAdopted(existing, test.req)
+			if diags.ErrorsCount() != test.errors {
+				t.Errorf("expect %d errors, got %v", test.errors, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+const StatusToDelete = "TO_DELETE"
+
+const migrationPollInterval = 5 * time.Second
+
+// Model is a resource model holding the attributes shared by every addon.
+// attributes.Addon implements it, so models embedding it do too.
+type Model interface {
+	ToAddon() attributes.Addon
+	FromAddon(attributes.Addon)
+}
+
+// Engine implements the steps shared by every addon resource.
+// M is the resource model, D the provider specific detail of the addon.
+type Engine[M Model, D any] struct {
+	ProviderID string
+	// Plan used when the model has none, the first available one if empty
+	DefaultPlan string
+	// Identify the addon by its real ID (kv_..., keycloak_...) instead of the addon_... one
+	UseRealID bool
+
+	// Fetch the provider specific detail of an addon
+	GetDetail func(ctx context.Context, cc *client.Client, organisation, addonID string) client.Response[D]
+	// Status exposed by the detail, nil if the provider has none
+	Status func(*D) string
+	// Map the detail on the model
+	MapDetail func(M, *D)
+	// Refresh the attributes not exposed by the detail, nil if there are none
+	Refresh func(ctx context.Context, cc *client.Client, organisation string, model M) diag.Diagnostics
+}
+
+// LookupPlan finds a provider plan by its slug, case insensitive
+func (e Engine[M, D]) LookupPlan(ctx context.Context, cc *client.Client, slug string) (*tmp.AddonPlan, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	addonsProvidersRes := tmp.GetAddonsProviders(ctx, cc)
+	if addonsProvidersRes.HasError() {
+		diags.AddError("failed to get addon providers", addonsProvidersRes.Error().Error())
+		return nil, diags
+	}
+
+	addonsProviders := *addonsProvidersRes.Payload()
+	provider := pkg.LookupAddonProvider(addonsProviders, e.ProviderID)
+	if provider == nil {
+		diags.AddError("failed to find addon provider", fmt.Sprintf("expect: %s, got: %s", strings.Join(pkg.AddonProvidersAsList(addonsProviders), ", "), e.ProviderID))
+		return nil, diags
+	}
+
+	if slug == "" {
+		slug = e.DefaultPlan
+	}
+	if slug == "" && len(provider.Plans) > 0 {
+		return &provider.Plans[0], diags
+	}
+
+	plan := pkg.LookupProviderPlan(provider, slug)
+	if plan == nil {
+		diags.AddAttributeError(path.Root("plan"), "failed to find plan", "expect: "+strings.Join(pkg.ProviderPlansAsList(provider), ", ")+", got: "+slug)
+		return nil, diags
+	}
+
+	return plan, diags
+}
+
+// Create the addon, or adopt an existing one, and fill the generated attributes of the model.
+// Options are validated against the ones advertised by the provider.
+func (e Engine[M, D]) Create(ctx context.Context, cc *client.Client, organisation string, model M, options map[string]string) diag.Diagnostics {
+	a := model.ToAddon()
+
+	plan, diags := e.LookupPlan(ctx, cc, a.Plan.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(ValidateOptions(ctx, cc, e.ProviderID, options)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	addonReq := tmp.AddonRequest{
+		Name:       a.Name.ValueString(),
+		Plan:       plan.ID,
+		Options:    options,
+		ProviderID: e.ProviderID,
+		Region:     a.Region.ValueString(),
+	}
+
+	createdAddon, createDiags := CreateOrAdopt(ctx, cc, organisation, addonReq, a.AdoptExisting.ValueBool())
+	diags.Append(createDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	a.ID = pkg.FromStr(e.id(createdAddon))
+	a.CreationDate = pkg.FromI(createdAddon.CreationDate)
+	model.FromAddon(a)
+
+	return diags
+}
+
+// WaitForActive waits for the addon to be provisioned, when the provider exposes a status,
+// and maps its detail on the model
+func (e Engine[M, D]) WaitForActive(ctx context.Context, cc *client.Client, organisation string, model M) diag.Diagnostics {
+	addonID := model.ToAddon().ID.ValueString()
+
+	if e.Status == nil {
+		return e.refreshDetail(ctx, cc, organisation, model)
+	}
+
+	detail, diags := WaitForActive(ctx, func() client.Response[D] {
+		return e.GetDetail(ctx, cc, organisation, addonID)
+	}, e.Status)
+	if diags.HasError() {
+		return diags
+	}
+
+	e.MapDetail(model, detail)
+	diags.Append(e.refresh(ctx, cc, organisation, model)...)
+	return diags
+}
+
+// Read refreshes the model, false is returned when the addon does not exist anymore
+func (e Engine[M, D]) Read(ctx context.Context, cc *client.Client, organisation string, model M) (bool, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	a := model.ToAddon()
+
+	addonRes := tmp.GetAddon(ctx, cc, organisation, a.ID.ValueString())
+	if addonRes.IsNotFoundError() {
+		return false, diags
+	}
+	if addonRes.HasError() {
+		diags.AddError("failed to get addon", addonRes.Error().Error())
+		return false, diags
+	}
+
+	detailRes := e.GetDetail(ctx, cc, organisation, a.ID.ValueString())
+	if detailRes.IsNotFoundError() {
+		return false, diags
+	}
+	if detailRes.HasError() {
+		diags.AddError("failed to get addon details", detailRes.Error().Error())
+		return false, diags
+	}
+
+	detail := detailRes.Payload()
+	if e.Status != nil && e.Status(detail) == StatusToDelete {
+		return false, diags
+	}
+
+	remote := addonRes.Payload()
+	a.Name = pkg.FromStr(remote.Name)
+	a.Region = pkg.FromStr(remote.Region)
+	a.CreationDate = pkg.FromI(remote.CreationDate)
+	// keep the configured case
+	if !strings.EqualFold(a.Plan.ValueString(), remote.Plan.Slug) {
+		a.Plan = pkg.FromStr(remote.Plan.Slug)
+	}
+	model.FromAddon(a)
+
+	e.MapDetail(model, detail)
+	diags.Append(e.refresh(ctx, cc, organisation, model)...)
+	return true, diags
+}
+
+// Update renames the addon and migrates it to the planned plan.
+// The planned model becomes the new state, its remote attributes are refreshed.
+func (e Engine[M, D]) Update(ctx context.Context, cc *client.Client, organisation string, state, plan M) diag.Diagnostics {
+	current, next := state.ToAddon(), plan.ToAddon()
+	// only known once created
+	next.ID = current.ID
+	next.CreationDate = current.CreationDate
+	plan.FromAddon(next)
+
+	addonID, diags := e.addonID(ctx, cc, organisation, current.ID.ValueString())
+	if diags.HasError() {
+		return diags
+	}
+
+	if !next.Name.Equal(current.Name) {
+		diags.Append(Rename(ctx, cc, organisation, addonID, next.Name.ValueString())...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if !strings.EqualFold(next.Plan.ValueString(), current.Plan.ValueString()) {
+		diags.Append(e.migrate(ctx, cc, addonID, next.Plan.ValueString(), current.Region.ValueString())...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// a migration moves the addon, connection informations may have changed
+	diags.Append(e.refreshDetail(ctx, cc, organisation, plan)...)
+	return diags
+}
+
+// Delete the addon, an already deleted one is not an error
+func (e Engine[M, D]) Delete(ctx context.Context, cc *client.Client, organisation string, model M) diag.Diagnostics {
+	addonID, diags := e.addonID(ctx, cc, organisation, model.ToAddon().ID.ValueString())
+	if diags.HasError() || addonID == "" {
+		return diags
+	}
+
+	res := tmp.DeleteAddon(ctx, cc, organisation, addonID)
+	if res.HasError() && !res.IsNotFoundError() {
+		diags.AddError("failed to delete addon", res.Error().Error())
+	}
+
+	return diags
+}
+
+func (e Engine[M, D]) id(addon *tmp.AddonResponse) string {
+	if e.UseRealID {
+		return addon.RealID
+	}
+	return addon.ID
+}
+
+// addonID resolves the addon_... ID, some endpoints do not support real IDs.
+// It is empty when the addon does not exist.
+func (e Engine[M, D]) addonID(ctx context.Context, cc *client.Client, organisation, id string) (string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if !e.UseRealID {
+		return id, diags
+	}
+
+	addonRes := tmp.GetAddon(ctx, cc, organisation, id)
+	if addonRes.IsNotFoundError() {
+		return "", diags
+	}
+	if addonRes.HasError() {
+		diags.AddError("failed to get addon", addonRes.Error().Error())
+		return "", diags
+	}
+
+	return addonRes.Payload().ID, diags
+}
+
+func (e Engine[M, D]) refreshDetail(ctx context.Context, cc *client.Client, organisation string, model M) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	detailRes := e.GetDetail(ctx, cc, organisation, model.ToAddon().ID.ValueString())
+	if detailRes.HasError() {
+		diags.AddError("failed to get addon details", detailRes.Error().Error())
+		return diags
+	}
+
+	e.MapDetail(model, detailRes.Payload())
+	diags.Append(e.refresh(ctx, cc, organisation, model)...)
+	return diags
+}
+
+func (e Engine[M, D]) refresh(ctx context.Context, cc *client.Client, organisation string, model M) diag.Diagnostics {
+	if e.Refresh == nil {
+		return diag.Diagnostics{}
+	}
+
+	return e.Refresh(ctx, cc, organisation, model)
+}
+
+// Rename the addon in place
+func Rename(ctx context.Context, cc *client.Client, organisation, addonID, name string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	res := tmp.UpdateAddon(ctx, cc, organisation, addonID, tmp.AddonUpdateRequest{Name: name})
+	if res.HasError() {
+		diags.AddError("failed to rename addon", res.Error().Error())
+	}
+
+	return diags
+}
+
+// migrate the addon to another plan and wait for the migration to finish
+func (e Engine[M, D]) migrate(ctx context.Context, cc *client.Client, addonID, planSlug, region string) diag.Diagnostics {
+	plan, diags := e.LookupPlan(ctx, cc, planSlug)
+	if diags.HasError() {
+		return diags
+	}
+
+	migrationRes := tmp.MigrateAddon(ctx, cc, e.ProviderID, addonID, tmp.AddonMigrationRequest{
+		PlanID: plan.ID,
+		Region: region,
+	})
+	if migrationRes.HasError() {
+		diags.AddError("failed to migrate addon", migrationRes.Error().Error())
+		return diags
+	}
+
+	migration := migrationRes.Payload()
+	tflog.Info(ctx, "addon migration started", map[string]interface{}{"addon": addonID, "migration": migration.ID, "plan": planSlug})
+
+	err := pkg.WaitFor(ctx, migrationPollInterval, func() (bool, error) {
+		res := tmp.GetAddonMigration(ctx, cc, e.ProviderID, addonID, migration.ID)
+		if res.HasError() {
+			return false, res.Error()
+		}
+
+		switch res.Payload().Status {
+		case tmp.AddonMigrationOK:
+			return true, nil
+		case tmp.AddonMigrationFailed:
+			return false, fmt.Errorf("migration %s to plan '%s' failed", migration.ID, planSlug)
+		default:
+			return false, nil
+		}
+	})
+	if err != nil {
+		diags.AddError("failed to migrate addon", err.Error())
+	}
+
+	return diags
+}
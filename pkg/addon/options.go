@@ -0,0 +1,112 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// Options sent at creation, every other option is the name of a boolean feature
+const (
+	OptionVersion        = "version"
+	OptionEncryption     = "encryption"
+	OptionDirectHostOnly = "direct-host-only"
+	OptionKibana         = "kibana"
+	OptionAPM            = "apm"
+)
+
+// ValidateOptions checks the options against the ones advertised by the provider
+func ValidateOptions(ctx context.Context, cc *client.Client, providerID string, options map[string]string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if len(options) == 0 {
+		return diags
+	}
+
+	infoRes := tmp.GetAddonProviderInfo(ctx, cc, providerID)
+	if infoRes.IsNotFoundError() {
+		diags.AddError("invalid addon options", fmt.Sprintf("provider '%s' does not advertise any option", providerID))
+		return diags
+	}
+	if infoRes.HasError() {
+		diags.AddError("failed to get addon provider options", infoRes.Error().Error())
+		return diags
+	}
+
+	return validateOptions(infoRes.Payload(), options)
+}
+
+func validateOptions(info *tmp.AddonProviderInfo, options map[string]string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	version, ok := options[OptionVersion]
+	if !ok {
+		version = info.DefaultDedicatedVersion
+	}
+
+	dedicated, ok := info.Dedicated[version]
+	if !ok {
+		diags.AddError("invalid addon options", fmt.Sprintf("version '%s' is not available for %s, expect one of: %s", version, info.ProviderID, strings.Join(sortedKeys(info.Dedicated), ", ")))
+		return diags
+	}
+
+	features := pkg.Map(dedicated.Features, func(feature tmp.AddonFeature) string {
+		return feature.Name
+	})
+
+	for _, name := range sortedKeys(options) {
+		if name == OptionVersion {
+			continue
+		}
+
+		if !slices.Contains(features, name) {
+			diags.AddError("invalid addon options", fmt.Sprintf("option '%s' is not supported by %s %s, expect one of: %s", name, info.ProviderID, version, strings.Join(features, ", ")))
+			continue
+		}
+
+		if value := options[name]; value != "true" && value != "false" {
+			diags.AddError("invalid addon options", fmt.Sprintf("option '%s' expects 'true' or 'false', got '%s'", name, value))
+		}
+	}
+
+	return diags
+}
+
+// FeatureEnabled tells if the named feature is enabled on an existing addon
+func FeatureEnabled(features []tmp.AddonFeature, name string) bool {
+	return pkg.HasSome(features, func(feature tmp.AddonFeature) bool {
+		return feature.Name == name && feature.Enabled
+	})
+}
+
+// VersionMatches tells if the remote version is the configured one, or a more precise one (7.2.4 for 7 or 7.2)
+func VersionMatches(configured, remote string) bool {
+	return remote == configured || strings.HasPrefix(remote, configured+".")
+}
+
+// Version keeps the configured version while the remote one matches it,
+// a version pinned on its major would be replaced on every plan otherwise
+func Version(configured types.String, remote string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && VersionMatches(configured.ValueString(), remote) {
+		return configured
+	}
+
+	return pkg.FromStr(remote)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
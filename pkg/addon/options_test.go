@@ -0,0 +1,67 @@
+package addon
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+func TestValidateOptions(t *testing.T) {
+	info := &tmp.AddonProviderInfo{
+		ProviderID: "postgresql-addon",
+		Dedicated: map[string]tmp.AddonProviderVersion{
+			"14": {Features: []tmp.AddonFeature{{Name: "encryption"}}},
+			"15": {Features: []tmp.AddonFeature{{Name: "encryption"}, {Name: "direct-host-only"}}},
+		},
+		DefaultDedicatedVersion: "15",
+	}
+
+	tests := []struct {
+		name    string
+		options map[string]string
+		errors  int
+	}{
+		{"default version", map[string]string{"direct-host-only": "true"}, 0},
+		{"pinned version", map[string]string{"version": "14", "encryption": "false"}, 0},
+		{"unknown version", map[string]string{"version": "9"}, 1},
+		{"unsupported feature", map[string]string{"version": "14", "direct-host-only": "true"}, 1},
+		{"not a boolean", map[string]string{"encryption": "yes", "kibana": "true"}, 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateOptions(info, test.options)
+			if diags.ErrorsCount() != test.errors {
+				t.Errorf("expect %d errors, got %v", test.errors, diags)
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured types.String
+		remote     string
+		expect     types.String
+	}{
+		{"same version", types.StringValue("7.2"), "7.2", types.StringValue("7.2")},
+		{"pinned major", types.StringValue("7"), "7.2.4", types.StringValue("7")},
+		{"pinned minor", types.StringValue("7.2"), "7.2.4", types.StringValue("7.2")},
+		{"other minor", types.StringValue("7.2"), "7.20.1", types.StringValue("7.20.1")},
+		{"other major", types.StringValue("1"), "17.2", types.StringValue("17.2")},
+		{"drift", types.StringValue("14"), "15.4", types.StringValue("15.4")},
+		{"not configured", types.StringUnknown(), "15.4", types.StringValue("15.4")},
+		{"imported", types.StringNull(), "15.4", types.StringValue("15.4")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version := Version(test.configured, test.remote)
+			if !version.Equal(test.expect) {
+				t.Errorf("expect %s, got %s", test.expect, version)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+package addon
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// ModelPointer is a pointer to a resource model, the one the engine works on
+type ModelPointer[T any] interface {
+	*T
+	Model
+}
+
+// Resource implements the Terraform lifecycle of an addon on top of its engine:
+// timeouts, tags, deletion protection and state handling.
+// Addon resources embed it and only declare their schema.
+type Resource[T any, M ModelPointer[T], D any] struct {
+	Engine Engine[M, D]
+	// Resource type name, without the provider one
+	TypeName string
+	// Options sent at creation, nil if the addon has none
+	Options func(M) map[string]string
+	// Check the attributes exposed once the addon is active, nil if there is nothing to check
+	CheckActive func(M) diag.Diagnostics
+
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func (r *Resource[T, M, D]) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_" + r.TypeName
+}
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *Resource[T, M, D]) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "Resource.Configure()", map[string]interface{}{"type": r.TypeName})
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
+	}
+}
+
+func (r *Resource[T, M, D]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+
+	// options are only sent on creation, any change replaces the addon
+	creation := req.State.Raw.IsNull() || len(res.RequiresReplace) > 0
+	if !creation || r.cc == nil || r.Options == nil {
+		return
+	}
+
+	model := M(new(T))
+	res.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(ValidateOptions(ctx, r.cc, r.Engine.ProviderID, r.Options(model))...)
+}
+
+// Create a new resource
+func (r *Resource[T, M, D]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	model := M(new(T))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := model.ToAddon().Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var options map[string]string
+	if r.Options != nil {
+		options = r.Options(model)
+	}
+
+	resp.Diagnostics.Append(r.Engine.Create(ctx, r.cc, r.org, model, options)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a := model.ToAddon()
+	tags, diags := pkg.TagsWithDefaults(ctx, a.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	a.TagsAll = pkg.FromSetString(tags)
+	model.FromAddon(a)
+	resp.Diagnostics.Append(r.syncTags(ctx, a.ID.ValueString(), tags)...)
+
+	// the addon exists from now on, save it so it is tainted on failure,
+	// attributes only known once it is active are null until then
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// dependent apps must not be deployed while the addon is provisioning
+	resp.Diagnostics.Append(r.Engine.WaitForActive(ctx, r.cc, r.org, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.CheckActive != nil {
+		resp.Diagnostics.Append(r.CheckActive(model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Read resource information
+func (r *Resource[T, M, D]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Addon READ", map[string]interface{}{"type": r.TypeName, "request": req})
+
+	model := M(new(T))
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := model.ToAddon().Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	found, diags := r.Engine.Read(ctx, r.cc, r.org, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	a := model.ToAddon()
+	addonID, diags := r.Engine.addonID(ctx, r.cc, r.org, a.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsRes := tmp.GetAddonTags(ctx, r.cc, r.org, addonID)
+	if tagsRes.HasError() {
+		resp.Diagnostics.AddError("failed to get addon tags", tagsRes.Error().Error())
+		return
+	}
+
+	a.TagsAll = pkg.FromSetString(*tagsRes.Payload())
+	a.Tags, diags = pkg.TagsFromRemote(ctx, *tagsRes.Payload(), a.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.FromAddon(a)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update resource
+func (r *Resource[T, M, D]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan, state := M(new(T)), M(new(T))
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.ToAddon().Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.Engine.Update(ctx, r.cc, r.org, state, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a := plan.ToAddon()
+	tags, diags := pkg.TagsWithDefaults(ctx, a.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncTags(ctx, a.ID.ValueString(), tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.TagsAll = pkg.FromSetString(tags)
+	plan.FromAddon(a)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete resource
+func (r *Resource[T, M, D]) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	model := M(new(T))
+
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a := model.ToAddon()
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(a.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := a.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Addon DELETE", map[string]interface{}{"type": r.TypeName, "id": a.ID.ValueString()})
+
+	resp.Diagnostics.Append(r.Engine.Delete(ctx, r.cc, r.org, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Tags are set on the addon_... ID, even for addons identified by their real ID
+func (r *Resource[T, M, D]) syncTags(ctx context.Context, id string, tags []string) diag.Diagnostics {
+	addonID, diags := r.Engine.addonID(ctx, r.cc, r.org, id)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(pkg.SyncAddonTags(ctx, r.cc, r.org, addonID, tags)...)
+	return diags
+}
+
+// Import resource
+func (r *Resource[T, M, D]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id attribute
+	// and call Read() to fill fields
+	attr := path.Root("id")
+	resource.ImportStatePassthroughID(ctx, attr, req, resp)
+}
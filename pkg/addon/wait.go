@@ -0,0 +1,50 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.dev/client"
+)
+
+const (
+	StatusActive = "ACTIVE"
+
+	statusPollInitialDelay = 2 * time.Second
+	statusPollMaxDelay     = 30 * time.Second
+)
+
+// WaitForActive polls the addon until its status is ACTIVE, or the context (create timeout) expires.
+// The last fetched addon is returned so its details can be used right away.
+// A not found addon is considered as not provisioned yet.
+func WaitForActive[T any](ctx context.Context, get func() client.Response[T], status func(*T) string) (*T, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var addon *T
+	lastStatus := ""
+	err := pkg.WaitWithBackoff(ctx, statusPollInitialDelay, statusPollMaxDelay, func() (bool, error) {
+		res := get()
+		if res.IsNotFoundError() {
+			return false, nil
+		}
+		if res.HasError() {
+			return false, res.Error()
+		}
+
+		addon = res.Payload()
+		lastStatus = status(addon)
+		tflog.Debug(ctx, "waiting for addon", map[string]interface{}{"status": lastStatus})
+
+		return lastStatus == StatusActive, nil
+	})
+	if err != nil {
+		diags.AddError("addon is not active", fmt.Sprintf("last status: '%s', %s", lastStatus, err.Error()))
+		return nil, diags
+	}
+
+	return addon, diags
+}
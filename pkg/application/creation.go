@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
@@ -18,6 +19,8 @@ type CreateReq struct {
 	VHosts       []string
 	Deployment   *Deployment
 	Dependencies []string
+	Tags         []string
+	Running      bool
 }
 
 type UpdateReq struct {
@@ -29,6 +32,8 @@ type UpdateReq struct {
 	VHosts       []string
 	Deployment   *Deployment
 	Dependencies []string
+	Tags         []string
+	Running      bool
 }
 
 type Deployment struct {
@@ -42,6 +47,8 @@ type CreateRes struct {
 	Application tmp.CreatAppResponse
 }
 
+// CreateApp returns nil only when the application was not created,
+// later failures are reported as diagnostics along with the created application
 func CreateApp(ctx context.Context, req CreateReq) (*CreateRes, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
@@ -71,9 +78,13 @@ func CreateApp(ctx context.Context, req CreateReq) (*CreateRes, diag.Diagnostics
 		}
 	}
 
-	// Git Deployment
-	if req.Deployment != nil {
-		diags.Append(gitDeploy(ctx, *req.Deployment, req.Client, res.Application.DeployURL)...)
+	// Tags
+	diags.Append(SyncAppTags(ctx, req.Client, req.Organization, res.Application.ID, req.Tags)...)
+
+	// Git Deployment, a stopped application is not deployed
+	if req.Deployment != nil && req.Running {
+		_, deployDiags := gitDeploy(ctx, *req.Deployment, req.Client, res.Application.DeployURL)
+		diags.Append(deployDiags...)
 	}
 
 	// Dependencies
@@ -120,9 +131,20 @@ func UpdateApp(ctx context.Context, req UpdateReq) (*CreateRes, diag.Diagnostics
 	}
 	// TODO: old vhost need to be cleaned
 
-	// Git Deployment
-	if req.Deployment != nil {
-		diags.Append(gitDeploy(ctx, *req.Deployment, req.Client, res.Application.DeployURL)...)
+	// Tags
+	diags.Append(SyncAppTags(ctx, req.Client, req.Organization, res.Application.ID, req.Tags)...)
+
+	// Git Deployment, a stopped application is not redeployed
+	pushed := false
+	if req.Deployment != nil && req.Running {
+		var deployDiags diag.Diagnostics
+		pushed, deployDiags = gitDeploy(ctx, *req.Deployment, req.Client, res.Application.DeployURL)
+		diags.Append(deployDiags...)
+	}
+
+	// Running state, pushed commits already start the application
+	if !pushed {
+		diags.Append(syncRunning(ctx, req.Client, req.Organization, res.Application, req.Running)...)
 	}
 
 	// Dependencies
@@ -148,3 +170,20 @@ func FromForceHTTPS(force bool) string {
 		return "DISABLED"
 	}
 }
+
+// Add and remove application tags to match the wanted ones
+func SyncAppTags(ctx context.Context, cc *client.Client, organisation, applicationID string, tags []string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	tagsRes := tmp.GetAppTags(ctx, cc, organisation, applicationID)
+	if tagsRes.HasError() {
+		diags.AddError("failed to get application tags", tagsRes.Error().Error())
+		return diags
+	}
+
+	return pkg.SyncTags(*tagsRes.Payload(), tags, func(tag string) error {
+		return tmp.AddAppTag(ctx, cc, organisation, applicationID, tag).Error()
+	}, func(tag string) error {
+		return tmp.DeleteAppTag(ctx, cc, organisation, applicationID, tag).Error()
+	})
+}
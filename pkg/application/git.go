@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"go.clever-cloud.dev/client"
 )
 
-func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemote string) diag.Diagnostics {
+// gitDeploy pushes the repository to the application, true is returned when commits were pushed,
+// which triggers a deployment
+func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemote string) (bool, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
 	cleverRemote = strings.Replace(cleverRemote, "git+ssh", "https", 1) // switch protocol
@@ -28,8 +31,8 @@ func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemot
 
 	r, err := git.CloneContext(ctx, memory.NewStorage(), nil, cloneOpts)
 	if err != nil {
-		diags.AddError("failed to clone repository", err.Error())
-		return diags
+		diags.AddError("failed to clone repository", deadlineError(ctx, err).Error())
+		return false, diags
 	}
 
 	remoteOpts := &config.RemoteConfig{
@@ -40,7 +43,7 @@ func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemot
 	remote, err := r.CreateRemote(remoteOpts)
 	if err != nil {
 		diags.AddError("failed to add clever remote", err.Error())
-		return diags
+		return false, diags
 	}
 
 	token, secret := cc.Oauth1UserCredentials()
@@ -59,7 +62,7 @@ func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemot
 		ref := config.RefSpec(fmt.Sprintf("%s:refs/heads/master", *d.Commit))
 		if err := ref.Validate(); err != nil {
 			diags.AddError("failed to build ref spec to push", err.Error())
-			return diags
+			return false, diags
 		}
 
 		pushOptions.RefSpecs = []config.RefSpec{ref}
@@ -70,10 +73,23 @@ func gitDeploy(ctx context.Context, d Deployment, cc *client.Client, cleverRemot
 	})
 
 	err = remote.PushContext(ctx, pushOptions)
-	if err != nil && err != git.NoErrAlreadyUpToDate {
-		diags.AddError("failed to push to clever remote", err.Error())
-		return diags
+	if err == git.NoErrAlreadyUpToDate {
+		return false, diags
+	}
+	if err != nil {
+		diags.AddError("failed to push to clever remote", deadlineError(ctx, err).Error())
+		return false, diags
+	}
+
+	return true, diags
+}
+
+// git errors hide the reason of an interrupted transfer,
+// make it explicit when the resource timeout has been reached
+func deadlineError(ctx context.Context, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timeout reached, consider raising it in the timeouts block: %w", err)
 	}
 
-	return diags
+	return err
 }
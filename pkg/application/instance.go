@@ -4,21 +4,24 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
 
 // Lookup for the instance matching this criteria
-// return the
-func LookupInstance(ctx context.Context, cc *client.Client, kind, name string, diags diag.Diagnostics) *tmp.ProductInstance {
+// return the one matching the given version, or the latest one if version is empty
+func LookupInstance(ctx context.Context, cc *client.Client, kind, name, version string) (*tmp.ProductInstance, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
 
 	productRes := tmp.GetProductInstance(ctx, cc)
 	if productRes.HasError() {
 		diags.AddError("failed to get variant", productRes.Error().Error())
-		return nil
+		return nil, diags
 	}
 
 	instances := *productRes.Payload()
@@ -29,7 +32,7 @@ func LookupInstance(ctx context.Context, cc *client.Client, kind, name string, d
 
 	if len(instanceKind) == 0 {
 		diags.AddError("failed to get variant", fmt.Sprintf("there id no product matching type '%s'", kind))
-		return nil
+		return nil, diags
 	}
 
 	variants := pkg.Filter(instanceKind, func(instance tmp.ProductInstance) bool {
@@ -38,14 +41,33 @@ func LookupInstance(ctx context.Context, cc *client.Client, kind, name string, d
 
 	if len(variants) == 0 {
 		diags.AddError("failed to get variant", fmt.Sprintf("there id no product matching this name '%s'", name))
-		return nil
-	} else if len(variants) > 1 {
+		return nil, diags
+	}
+
+	if version != "" {
+		pinned := pkg.Filter(variants, func(instance tmp.ProductInstance) bool {
+			return instance.Version == version
+		})
+		if len(pinned) == 0 {
+			versions := pkg.Map(variants, func(instance tmp.ProductInstance) string { return instance.Version })
+			diags.AddAttributeError(
+				path.Root("instance_version"),
+				"invalid instance version",
+				fmt.Sprintf("there is no version '%s' for '%s', available ones: %s", version, name, strings.Join(versions, ", ")),
+			)
+			return nil, diags
+		}
+
+		return &pinned[0], diags
+	}
+
+	if len(variants) > 1 {
 		diags.AddWarning("failed to get the right variant", "more than one variant match this criteria, take last one")
 	}
 
 	variant := lastVariant(variants)
 
-	return &variant
+	return &variant, diags
 }
 
 func lastVariant(variants []tmp.ProductInstance) tmp.ProductInstance {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
@@ -13,6 +14,7 @@ type ReadAppRes struct {
 	App          tmp.CreatAppResponse
 	AppIsDeleted bool
 	Env          []tmp.Env
+	Tags         []string
 }
 
 func ReadApp(ctx context.Context, cc *client.Client, orgId, appId string) (*ReadAppRes, diag.Diagnostics) {
@@ -43,6 +45,14 @@ func ReadApp(ctx context.Context, cc *client.Client, orgId, appId string) (*Read
 
 	r.Env = *envRes.Payload()
 
+	tagsRes := tmp.GetAppTags(ctx, cc, orgId, appId)
+	if tagsRes.HasError() {
+		diags.AddError("failed to get app tags", tagsRes.Error().Error())
+		return r, diags
+	}
+
+	r.Tags = *tagsRes.Payload()
+
 	return r, diags
 }
 
@@ -55,3 +65,17 @@ func (r ReadAppRes) EnvAsMap() map[string]string {
 			return acc
 		})
 }
+
+// TrackedVHosts returns the domains of the previous state still attached to the application.
+// Other domains are left out of the diff, they may be managed by clevercloud_vhost
+func TrackedVHosts(ctx context.Context, vhosts []string, tracked types.List) []string {
+	if tracked.IsNull() || tracked.IsUnknown() {
+		return []string{}
+	}
+
+	trackedVHosts := []string{}
+	tracked.ElementsAs(ctx, &trackedVHosts, false)
+
+	attached := pkg.NewSet(vhosts...)
+	return pkg.Filter(trackedVHosts, attached.Contains)
+}
@@ -0,0 +1,25 @@
+package application
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+)
+
+func TestTrackedVHosts(t *testing.T) {
+	ctx := context.Background()
+	attached := []string{"app-1234.cleverapps.io", "www.example.com", "api.example.com", "standalone.example.com"}
+
+	tracked := TrackedVHosts(ctx, attached, pkg.FromListString([]string{"api.example.com", "www.example.com", "removed.example.com"}))
+	expected := []string{"api.example.com", "www.example.com"}
+	if !reflect.DeepEqual(tracked, expected) {
+		t.Errorf("expect %v, got %v", expected, tracked)
+	}
+
+	if tracked := TrackedVHosts(ctx, attached, types.ListNull(types.StringType)); len(tracked) != 0 {
+		t.Errorf("expect no vhost without previous state, got %v", tracked)
+	}
+}
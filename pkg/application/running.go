@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// application state once stopped
+const stateStopped = "SHOULD_BE_DOWN"
+
+// SetRunning starts or stops an application to match the wanted state
+func SetRunning(ctx context.Context, cc *client.Client, organisation, applicationID string, running bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	appRes := tmp.GetApp(ctx, cc, organisation, applicationID)
+	if appRes.HasError() {
+		diags.AddError("failed to get application", appRes.Error().Error())
+		return diags
+	}
+
+	return syncRunning(ctx, cc, organisation, *appRes.Payload(), running)
+}
+
+func syncRunning(ctx context.Context, cc *client.Client, organisation string, app tmp.CreatAppResponse, running bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	isRunning := app.State != stateStopped
+
+	switch {
+	case running && !isRunning && app.CommitID != "":
+		// an application never deployed has nothing to start
+		startRes := tmp.StartApp(ctx, cc, organisation, app.ID)
+		if startRes.HasError() {
+			diags.AddError("failed to start application", startRes.Error().Error())
+		}
+	case !running && isRunning:
+		stopRes := tmp.StopApp(ctx, cc, organisation, app.ID)
+		if stopRes.HasError() {
+			diags.AddError("failed to stop application", stopRes.Error().Error())
+		}
+	}
+
+	return diags
+}
+
+// Running tells if the application is up or wanted up
+// An application never deployed keeps the previous value, as it cannot be started yet
+func (r ReadAppRes) Running(previous types.Bool) types.Bool {
+	if r.App.CommitID == "" && !previous.IsNull() {
+		return previous
+	}
+
+	return pkg.FromBool(r.App.State != stateStopped)
+}
@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// Check the planned runtime against the product catalog and the available zones
+// unknown values are skipped, they will be checked once known
+func ValidateRuntime(ctx context.Context, cc *client.Client, kind, name string, runtime attributes.Runtime) diag.Diagnostics {
+	instance, diags := LookupInstance(ctx, cc, kind, name, runtime.TargetInstanceVersion())
+	if diags.HasError() {
+		return diags
+	}
+
+	flavors := map[string]tmp.Flavors{}
+	for _, flavor := range instance.Flavors {
+		flavors[flavor.Name] = flavor
+	}
+	flavorNames := pkg.Map(instance.Flavors, func(flavor tmp.Flavors) string { return flavor.Name })
+
+	checkFlavor := func(attribute string, value types.String) (*tmp.Flavors, bool) {
+		if value.IsNull() || value.IsUnknown() {
+			return nil, false
+		}
+
+		flavor, ok := flavors[value.ValueString()]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"invalid flavor",
+				fmt.Sprintf("flavor '%s' is not available for '%s', available ones: %s", value.ValueString(), name, strings.Join(flavorNames, ", ")),
+			)
+			return nil, false
+		}
+
+		return &flavor, true
+	}
+
+	smallest, hasSmallest := checkFlavor("smallest_flavor", runtime.SmallestFlavor)
+	biggest, hasBiggest := checkFlavor("biggest_flavor", runtime.BiggestFlavor)
+	checkFlavor("build_flavor", runtime.BuildFlavor)
+
+	if hasSmallest && hasBiggest && isLargerFlavor(*smallest, *biggest) {
+		diags.AddAttributeError(
+			path.Root("smallest_flavor"),
+			"invalid flavor",
+			fmt.Sprintf("smallest flavor '%s' is larger than biggest flavor '%s'", smallest.Name, biggest.Name),
+		)
+	}
+
+	if !runtime.MaxInstanceCount.IsUnknown() && instance.MaxInstances > 0 &&
+		runtime.MaxInstanceCount.ValueInt64() > int64(instance.MaxInstances) {
+		diags.AddAttributeError(
+			path.Root("max_instance_count"),
+			"invalid instance count",
+			fmt.Sprintf("'%s' allows at most %d instances, got %d", name, instance.MaxInstances, runtime.MaxInstanceCount.ValueInt64()),
+		)
+	}
+
+	if !runtime.Region.IsNull() && !runtime.Region.IsUnknown() {
+		zonesRes := tmp.GetZones(ctx, cc)
+		if zonesRes.HasError() {
+			diags.AddError("failed to get zones", zonesRes.Error().Error())
+			return diags
+		}
+
+		zones := pkg.Map(*zonesRes.Payload(), func(zone tmp.Zone) string { return zone.Name })
+		if !pkg.HasSome(zones, func(zone string) bool { return zone == runtime.Region.ValueString() }) {
+			diags.AddAttributeError(
+				path.Root("region"),
+				"invalid region",
+				fmt.Sprintf("region '%s' does not exist, available ones: %s", runtime.Region.ValueString(), strings.Join(zones, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
+
+func isLargerFlavor(a, b tmp.Flavors) bool {
+	if a.Mem != b.Mem {
+		return a.Mem > b.Mem
+	}
+
+	return a.Cpus > b.Cpus
+}
@@ -1,7 +1,10 @@
 package attributes
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -11,11 +14,27 @@ import (
 )
 
 type Addon struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Plan         types.String `tfsdk:"plan"`
-	Region       types.String `tfsdk:"region"`
-	CreationDate types.Int64  `tfsdk:"creation_date"`
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Plan          types.String `tfsdk:"plan"`
+	Region        types.String `tfsdk:"region"`
+	CreationDate  types.Int64  `tfsdk:"creation_date"`
+	Tags          types.Set    `tfsdk:"tags"`
+	TagsAll       types.Set    `tfsdk:"tags_all"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ToAddon and FromAddon let the addon engine work on any model embedding Addon
+func (a Addon) ToAddon() Addon {
+	return a
+}
+
+func (a *Addon) FromAddon(addon Addon) {
+	*a = addon
 }
 
 var addonCommon = map[string]schema.Attribute{
@@ -27,10 +46,67 @@ var addonCommon = map[string]schema.Attribute{
 		Computed:            true,
 		Default:             stringdefault.StaticString("par"),
 		MarkdownDescription: "Geographical region where the data will be stored",
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 	},
-	"creation_date": schema.Int64Attribute{Computed: true, MarkdownDescription: "Date of database creation", PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+	"creation_date":       schema.Int64Attribute{Computed: true, MarkdownDescription: "Date of database creation", PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}},
+	"tags":                AddonTagsAttribute,
+	"tags_all":            AddonTagsAllAttribute,
+	"adopt_existing":      AdoptExistingAttribute,
+	"deletion_protection": DeletionProtectionAttribute,
+}
+
+// Available on every addon
+var AddonTagsAttribute = schema.SetAttribute{
+	Optional:            true,
+	ElementType:         types.StringType,
+	MarkdownDescription: "Tags to set on the addon, the provider `default_tags` are added to them",
+}
+
+var AddonTagsAllAttribute = schema.SetAttribute{
+	Computed:            true,
+	ElementType:         types.StringType,
+	MarkdownDescription: "Tags set on the addon, including the provider `default_tags`",
+}
+
+var AdoptExistingAttribute = schema.BoolAttribute{
+	Optional:            true,
+	Computed:            true,
+	Default:             booldefault.StaticBool(false),
+	MarkdownDescription: "Manage an existing addon with the same provider and name instead of failing at creation, its plan and region have to match",
+}
+
+// Options of database addons, sent at creation
+var VersionAttribute = schema.StringAttribute{
+	Optional:            true,
+	Computed:            true,
+	MarkdownDescription: "Version of the database, the provider default one if not set",
+	PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+}
+
+var EncryptionAttribute = schema.BoolAttribute{
+	Optional:            true,
+	Computed:            true,
+	Default:             booldefault.StaticBool(false),
+	MarkdownDescription: "Encrypt the data at rest",
+	PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+}
+
+var DirectHostOnlyAttribute = schema.BoolAttribute{
+	Optional:            true,
+	Computed:            true,
+	Default:             booldefault.StaticBool(false),
+	MarkdownDescription: "Only expose the direct host of the database, without going through the proxy",
+	PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
 }
 
 func WithAddonCommons(runtimeSpecifics map[string]schema.Attribute) map[string]schema.Attribute {
 	return pkg.Merge(addonCommon, runtimeSpecifics)
 }
+
+var addonBlocks = map[string]schema.Block{
+	"timeouts": TimeoutsBlock,
+}
+
+func WithBlockAddonCommons(addonSpecifics map[string]schema.Block) map[string]schema.Block {
+	return pkg.Merge(addonBlocks, addonSpecifics)
+}
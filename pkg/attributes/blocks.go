@@ -88,6 +88,7 @@ var blocks = map[string]schema.Block{
 			},
 		},
 	},
+	"timeouts": TimeoutsBlock,
 }
 
 func WithBlockRuntimeCommons(runtimeSpecifics map[string]schema.Block) map[string]schema.Block {
@@ -0,0 +1,32 @@
+package attributes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Available on every addon and runtime
+var DeletionProtectionAttribute = schema.BoolAttribute{
+	Optional:            true,
+	Computed:            true,
+	Default:             booldefault.StaticBool(false),
+	MarkdownDescription: "Prevent the resource from being destroyed, it has to be set to false and applied before any deletion",
+}
+
+// CheckDeletionProtection must be called at the very beginning of Delete, with the state value
+func CheckDeletionProtection(deletionProtection types.Bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if deletionProtection.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("deletion_protection"),
+			"deletion protection is enabled",
+			"this resource cannot be destroyed while deletion_protection is true, set it to false and apply before destroying it",
+		)
+	}
+
+	return diags
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,9 +37,28 @@ type Runtime struct {
 	Deployment       *Deployment  `tfsdk:"deployment"`
 	Hooks            *Hooks       `tfsdk:"hooks"`
 
+	Tags    types.Set  `tfsdk:"tags"`
+	TagsAll types.Set  `tfsdk:"tags_all"`
+	Running types.Bool `tfsdk:"running"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	// Advanced settings
+	CancelOnPush  types.Bool   `tfsdk:"cancel_on_push"`
+	Homogeneous   types.Bool   `tfsdk:"homogeneous"`
+	SeparateBuild types.Bool   `tfsdk:"separate_build"`
+	WebhookURL    types.String `tfsdk:"webhook_url"`
+	WebhookSecret types.String `tfsdk:"webhook_secret"`
+
+	// Instance version
+	InstanceVersion         types.String `tfsdk:"instance_version"`
+	ResolvedInstanceVersion types.String `tfsdk:"resolved_instance_version"`
+
 	// Env
 	AppFolder   types.String `tfsdk:"app_folder"`
 	Environment types.Map    `tfsdk:"environment"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // This attributes are used on several runtimes
@@ -69,7 +92,7 @@ var runtimeCommon = map[string]schema.Attribute{
 	},
 	"build_flavor": schema.StringAttribute{
 		Optional:            true,
-		MarkdownDescription: "Use dedicated instance with given flavor for build step",
+		MarkdownDescription: "Use dedicated instance with given flavor for build step, requires `separate_build`",
 	},
 	"region": schema.StringAttribute{
 		Optional:            true,
@@ -85,10 +108,49 @@ var runtimeCommon = map[string]schema.Attribute{
 		Optional:            true,
 		MarkdownDescription: "Redirect client from plain to TLS port",
 	},
+	"tags": schema.SetAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Tags to set on the application, the provider `default_tags` are added to them",
+	},
+	"tags_all": schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Tags set on the application, including the provider `default_tags`",
+	},
+	"cancel_on_push": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Cancel the ongoing deployment when a new commit is pushed",
+	},
+	"homogeneous": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Deploy all instances at once instead of a rolling deployment",
+	},
+	"running": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+		MarkdownDescription: "Keep the application started, set to false to stop it. A stopped application is not deployed",
+	},
+	"deletion_protection": DeletionProtectionAttribute,
+	"separate_build": schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		MarkdownDescription: "Build the application on a dedicated instance, sized with `build_flavor`",
+	},
 	"additional_vhosts": schema.ListAttribute{
 		ElementType:         types.StringType,
 		Optional:            true,
-		MarkdownDescription: "Add custom hostname in addition to the default one, see [documentation](https://www.clever-cloud.com/doc/administrate/domain-names/)",
+		MarkdownDescription: "Add custom hostname in addition to the default one, see [documentation](https://www.clever-cloud.com/doc/administrate/domain-names/). Hostnames attached outside of this list, for instance with `clevercloud_vhost`, are ignored",
+	},
+	"instance_version": schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Pin the instance image version, must exist in the product catalog. When omitted, the latest version is used at creation and kept afterward",
 	},
 	// APP_FOLDER
 	"app_folder": schema.StringAttribute{
@@ -107,6 +169,22 @@ var runtimeCommon = map[string]schema.Attribute{
 		MarkdownDescription: "Git URL used to push source code",
 		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 	},
+	"resolved_instance_version": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Instance image version the application is running on",
+		PlanModifiers:       []planmodifier.String{resolvedInstanceVersionModifier{}},
+	},
+	"webhook_url": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "URL to call from a CI to trigger a deployment",
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	},
+	"webhook_secret": schema.StringAttribute{
+		Computed:            true,
+		Sensitive:           true,
+		MarkdownDescription: "Secret used to sign webhook calls",
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+	},
 	// cleverapps one
 	"vhost": schema.StringAttribute{
 		Computed:            true,
@@ -151,6 +229,85 @@ var runtimeCommon = map[string]schema.Attribute{
 	},
 }
 
+// Checks which do not require the API, used in ValidateConfig()
+func (r Runtime) Validate() diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	// the API ignores the build flavor without a dedicated build instance
+	if !r.BuildFlavor.IsNull() && !r.BuildFlavor.IsUnknown() && !r.SeparateBuild.IsUnknown() && !r.SeparateBuild.ValueBool() {
+		diags.AddAttributeError(
+			path.Root("build_flavor"),
+			"build_flavor requires separate_build",
+			"set separate_build to true to build the application on a dedicated instance",
+		)
+	}
+
+	if r.MinInstanceCount.IsUnknown() || r.MaxInstanceCount.IsUnknown() {
+		return diags
+	}
+
+	if r.MinInstanceCount.ValueInt64() < 1 {
+		diags.AddAttributeError(
+			path.Root("min_instance_count"),
+			"invalid instance count",
+			fmt.Sprintf("expect at least 1 instance, got %d", r.MinInstanceCount.ValueInt64()),
+		)
+	}
+
+	if r.MinInstanceCount.ValueInt64() > r.MaxInstanceCount.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("min_instance_count"),
+			"invalid instance count",
+			fmt.Sprintf("min_instance_count (%d) is greater than max_instance_count (%d)", r.MinInstanceCount.ValueInt64(), r.MaxInstanceCount.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// Version to give to application.LookupInstance(), the pinned one or the one already running
+func (r Runtime) TargetInstanceVersion() string {
+	if !r.ResolvedInstanceVersion.IsUnknown() && !r.ResolvedInstanceVersion.IsNull() {
+		return r.ResolvedInstanceVersion.ValueString()
+	}
+
+	return r.InstanceVersion.ValueString()
+}
+
+// Keep the running version unless another one is pinned,
+// so a new version in the catalog does not change the app without a plan diff
+type resolvedInstanceVersionModifier struct{}
+
+func (m resolvedInstanceVersionModifier) Description(ctx context.Context) string {
+	return "use the pinned instance version, or the one from state"
+}
+
+func (m resolvedInstanceVersionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m resolvedInstanceVersionModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, res *planmodifier.StringResponse) {
+	pinned := types.StringNull()
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("instance_version"), &pinned)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if pinned.IsUnknown() {
+		res.PlanValue = types.StringUnknown()
+		return
+	}
+
+	if !pinned.IsNull() {
+		res.PlanValue = pinned
+		return
+	}
+
+	if !req.StateValue.IsNull() {
+		res.PlanValue = req.StateValue
+	}
+}
+
 func WithRuntimeCommons(runtimeSpecifics map[string]schema.Attribute) map[string]schema.Attribute {
 	return pkg.Merge(runtimeCommon, runtimeSpecifics)
 }
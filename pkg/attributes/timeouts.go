@@ -0,0 +1,25 @@
+package attributes
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+)
+
+// Durations used when the user does not override them in the timeouts block
+const (
+	DefaultCreateTimeout = 20 * time.Minute
+	DefaultReadTimeout   = 5 * time.Minute
+	DefaultUpdateTimeout = 20 * time.Minute
+	DefaultDeleteTimeout = 10 * time.Minute
+)
+
+// Timeouts block, available on every resource
+// https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts
+var TimeoutsBlock = timeouts.Block(context.Background(), timeouts.Opts{
+	Create: true,
+	Read:   true,
+	Update: true,
+	Delete: true,
+})
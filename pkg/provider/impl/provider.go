@@ -9,6 +9,7 @@ type Provider struct {
 	version      string
 	cc           *client.Client
 	organization string
+	defaultTags  []string
 }
 
 func New(version string) func() provider.Provider {
@@ -23,3 +24,6 @@ func (p *Provider) Organization() string {
 func (p *Provider) Client() *client.Client {
 	return p.cc
 }
+func (p *Provider) DefaultTags() []string {
+	return p.defaultTags
+}
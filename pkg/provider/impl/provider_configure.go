@@ -27,6 +27,12 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		p.organization = config.Organisation.ValueString()
 	}
 
+	p.defaultTags = []string{}
+	resp.Diagnostics.Append(config.DefaultTags.ElementsAs(ctx, &p.defaultTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Allow to get creds from CLI config directory or by injected variables
 	if config.Secret.IsUnknown() ||
 		config.Token.IsUnknown() ||
@@ -18,6 +18,7 @@ type ProviderData struct {
 	Token        types.String `tfsdk:"token"`
 	Secret       types.String `tfsdk:"secret"`
 	Organisation types.String `tfsdk:"organisation"`
+	DefaultTags  types.Set    `tfsdk:"default_tags"`
 }
 
 //go:embed provider.md
@@ -50,6 +51,11 @@ func (p *Provider) Schema(_ context.Context, req provider.SchemaRequest, res *pr
 					pkg.NewValidatorRegex("valid owner name", regexp.MustCompile(`^(user|orga)_.{36}`)),
 				},
 			},
+			"default_tags": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tags added on every application and addon managed by this provider, in addition to their own `tags`",
+			},
 		},
 	}
 }
@@ -8,4 +8,7 @@ type Provider interface {
 	Organization() string
 
 	Client() *client.Client
+
+	// Tags added on every application and addon
+	DefaultTags() []string
 }
@@ -0,0 +1,45 @@
+package pulsar
+
+import (
+	"context"
+	"net/http"
+)
+
+type Retention struct {
+	RetentionTimeInMinutes int64 `json:"retentionTimeInMinutes"`
+	RetentionSizeInMB      int64 `json:"retentionSizeInMB"`
+}
+
+// GetRetention returns the retention policy of the namespace, nil when not set
+func (c *AdminClient) GetRetention(ctx context.Context) (*Retention, error) {
+	var retention *Retention
+	if err := c.do(ctx, http.MethodGet, c.namespacePath()+"/retention", nil, &retention); err != nil {
+		return nil, err
+	}
+	return retention, nil
+}
+
+func (c *AdminClient) SetRetention(ctx context.Context, retention Retention) error {
+	return c.do(ctx, http.MethodPost, c.namespacePath()+"/retention", retention, nil)
+}
+
+func (c *AdminClient) RemoveRetention(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, c.namespacePath()+"/retention", nil, nil)
+}
+
+// GetMessageTTL returns the messages time to live of the namespace in seconds, nil when not set
+func (c *AdminClient) GetMessageTTL(ctx context.Context) (*int64, error) {
+	var ttl *int64
+	if err := c.do(ctx, http.MethodGet, c.namespacePath()+"/messageTTL", nil, &ttl); err != nil {
+		return nil, err
+	}
+	return ttl, nil
+}
+
+func (c *AdminClient) SetMessageTTL(ctx context.Context, seconds int64) error {
+	return c.do(ctx, http.MethodPost, c.namespacePath()+"/messageTTL", seconds, nil)
+}
+
+func (c *AdminClient) RemoveMessageTTL(ctx context.Context) error {
+	return c.do(ctx, http.MethodDelete, c.namespacePath()+"/messageTTL", nil, nil)
+}
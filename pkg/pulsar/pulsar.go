@@ -0,0 +1,156 @@
+package pulsar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+type PulsarCreds struct {
+	BinaryURL string
+	HTTPURL   string
+	Tenant    string
+	Namespace string
+	Token     string
+}
+
+// Extract Pulsar credentials from Clever Cloud Pulsar exposed env vars
+func FromEnvVars(envVars []tmp.EnvVar) *PulsarCreds {
+	creds := &PulsarCreds{}
+
+	for _, envVar := range envVars {
+		switch envVar.Name {
+		case "ADDON_PULSAR_BINARY_URL":
+			creds.BinaryURL = envVar.Value
+		case "ADDON_PULSAR_HTTP_URL":
+			creds.HTTPURL = envVar.Value
+		case "ADDON_PULSAR_TENANT":
+			creds.Tenant = envVar.Value
+		case "ADDON_PULSAR_NAMESPACE":
+			creds.Namespace = envVar.Value
+		case "ADDON_PULSAR_TOKEN":
+			creds.Token = envVar.Value
+		default:
+		}
+	}
+
+	return creds
+}
+
+func AdminClientFromEnvsFor(envVars []tmp.EnvVar) (*AdminClient, error) {
+	creds := FromEnvVars(envVars)
+	if creds.HTTPURL == "" || creds.Tenant == "" || creds.Namespace == "" {
+		return nil, fmt.Errorf("missing Pulsar HTTP URL, tenant or namespace in addon env")
+	}
+
+	return NewAdminClient(http.DefaultClient, creds), nil
+}
+
+// AdminClientFor builds an admin client from the env of a Pulsar addon
+func AdminClientFor(ctx context.Context, cc *client.Client, organisation, pulsarID string) (*AdminClient, error) {
+	envRes := tmp.GetAddonEnv(ctx, cc, organisation, pulsarID)
+	if envRes.HasError() {
+		return nil, fmt.Errorf("failed to get Pulsar env %s: %w", pulsarID, envRes.Error())
+	}
+
+	return AdminClientFromEnvsFor(*envRes.Payload())
+}
+
+// AdminClient calls the Pulsar admin REST API on the namespace of a Pulsar addon
+type AdminClient struct {
+	http  *http.Client
+	creds *PulsarCreds
+}
+
+func NewAdminClient(httpClient *http.Client, creds *PulsarCreds) *AdminClient {
+	return &AdminClient{http: httpClient, creds: creds}
+}
+
+// Error returned by the admin API on non 2xx responses
+type Error struct {
+	StatusCode int
+	Reason     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("pulsar admin API responded %d: %s", e.StatusCode, e.Reason)
+}
+
+func IsNotFound(err error) bool {
+	apiErr, ok := err.(*Error)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+// do sends body as JSON if not nil and decodes the response in out if not nil.
+// An empty response body leaves out untouched.
+func (c *AdminClient) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	url := strings.TrimSuffix(c.creds.HTTPURL, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.creds.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.creds.Token)
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		apiErr := &Error{StatusCode: res.StatusCode, Reason: strings.TrimSpace(string(resBody))}
+		// errors are usually wrapped as {"reason": "..."}
+		reason := struct {
+			Reason string `json:"reason"`
+		}{}
+		if json.Unmarshal(resBody, &reason) == nil && reason.Reason != "" {
+			apiErr.Reason = reason.Reason
+		}
+		return apiErr
+	}
+
+	if out == nil || len(bytes.TrimSpace(resBody)) == 0 {
+		return nil
+	}
+	return json.Unmarshal(resBody, out)
+}
+
+func (c *AdminClient) namespacePath() string {
+	return fmt.Sprintf("/admin/v2/namespaces/%s/%s", c.creds.Tenant, c.creds.Namespace)
+}
+
+func (c *AdminClient) topicsPath() string {
+	return fmt.Sprintf("/admin/v2/persistent/%s/%s", c.creds.Tenant, c.creds.Namespace)
+}
+
+// TopicName is the fully qualified name of a topic of the namespace
+func (c *AdminClient) TopicName(topic string) string {
+	return fmt.Sprintf("persistent://%s/%s/%s", c.creds.Tenant, c.creds.Namespace, topic)
+}
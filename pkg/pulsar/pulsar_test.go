@@ -0,0 +1,100 @@
+package pulsar
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func testAdminClient(t *testing.T, routes map[string]string) *AdminClient {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		body, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"reason":"Topic not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return NewAdminClient(server.Client(), &PulsarCreds{
+		HTTPURL:   server.URL + "/",
+		Tenant:    "tenant",
+		Namespace: "ns",
+		Token:     "token",
+	})
+}
+
+func TestGetTopicPartitions(t *testing.T) {
+	c := testAdminClient(t, map[string]string{
+		"GET /admin/v2/persistent/tenant/ns/partitioned":       `["persistent://tenant/ns/events"]`,
+		"GET /admin/v2/persistent/tenant/ns/events/partitions": `{"partitions":3}`,
+		"GET /admin/v2/persistent/tenant/ns":                   `["persistent://tenant/ns/logs","persistent://tenant/ns/events-partition-0"]`,
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		topic      string
+		partitions int64
+		exists     bool
+	}{
+		{"events", 3, true},
+		{"logs", 0, true},
+		{"missing", 0, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.topic, func(t *testing.T) {
+			partitions, exists, err := c.GetTopicPartitions(ctx, test.topic)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if partitions != test.partitions || exists != test.exists {
+				t.Errorf("expect (%d, %t), got (%d, %t)", test.partitions, test.exists, partitions, exists)
+			}
+		})
+	}
+
+	if err := c.DeleteTopic(ctx, "missing", 0); err != nil {
+		t.Errorf("expect deleting a missing topic to succeed, got %s", err)
+	}
+	if err := c.DeleteTopic(ctx, "missing", 2); err != nil {
+		t.Errorf("expect deleting a missing partitioned topic to succeed, got %s", err)
+	}
+}
+
+func TestNamespacePolicies(t *testing.T) {
+	c := testAdminClient(t, map[string]string{
+		"GET /admin/v2/namespaces/tenant/ns/retention":  `{"retentionTimeInMinutes":60,"retentionSizeInMB":-1}`,
+		"GET /admin/v2/namespaces/tenant/ns/messageTTL": ``,
+	})
+	ctx := context.Background()
+
+	retention, err := c.GetRetention(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if retention == nil || *retention != (Retention{RetentionTimeInMinutes: 60, RetentionSizeInMB: -1}) {
+		t.Errorf("unexpected retention: %+v", retention)
+	}
+
+	ttl, err := c.GetMessageTTL(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if ttl != nil {
+		t.Errorf("expect no TTL, got %d", *ttl)
+	}
+
+	err = c.SetMessageTTL(ctx, 3600)
+	if !IsNotFound(err) || err.Error() != "pulsar admin API responded 404: Topic not found" {
+		t.Errorf("expect a not found error, got %v", err)
+	}
+}
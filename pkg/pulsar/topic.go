@@ -0,0 +1,55 @@
+package pulsar
+
+import (
+	"context"
+	"net/http"
+	"slices"
+)
+
+// CreateTopic creates a persistent topic, a partitioned one when partitions is positive
+func (c *AdminClient) CreateTopic(ctx context.Context, topic string, partitions int64) error {
+	if partitions > 0 {
+		return c.do(ctx, http.MethodPut, c.topicsPath()+"/"+topic+"/partitions", partitions, nil)
+	}
+	return c.do(ctx, http.MethodPut, c.topicsPath()+"/"+topic, nil, nil)
+}
+
+// GetTopicPartitions returns the partitions count of a topic, 0 for a non partitioned one.
+// exists is false when the topic is not found in the namespace.
+func (c *AdminClient) GetTopicPartitions(ctx context.Context, topic string) (partitions int64, exists bool, err error) {
+	name := c.TopicName(topic)
+
+	partitioned := []string{}
+	if err := c.do(ctx, http.MethodGet, c.topicsPath()+"/partitioned", nil, &partitioned); err != nil {
+		return 0, false, err
+	}
+	if slices.Contains(partitioned, name) {
+		metadata := struct {
+			Partitions int64 `json:"partitions"`
+		}{}
+		if err := c.do(ctx, http.MethodGet, c.topicsPath()+"/"+topic+"/partitions", nil, &metadata); err != nil {
+			return 0, false, err
+		}
+		return metadata.Partitions, true, nil
+	}
+
+	topics := []string{}
+	if err := c.do(ctx, http.MethodGet, c.topicsPath(), nil, &topics); err != nil {
+		return 0, false, err
+	}
+	return 0, slices.Contains(topics, name), nil
+}
+
+// DeleteTopic deletes a topic, an already deleted one is not an error
+func (c *AdminClient) DeleteTopic(ctx context.Context, topic string, partitions int64) error {
+	path := c.topicsPath() + "/" + topic
+	if partitions > 0 {
+		path += "/partitions"
+	}
+
+	err := c.do(ctx, http.MethodDelete, path, nil, nil)
+	if IsNotFound(err) {
+		return nil
+	}
+	return err
+}
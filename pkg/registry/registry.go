@@ -6,18 +6,37 @@ import (
 	"go.clever-cloud.com/terraform-provider/pkg/resources/addon"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/cellar"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/cellar/bucket"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/certificate"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/docker"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/dotnet"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/drain"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/elasticsearch"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/elixir"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/golang"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/haskell"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/java"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/keycloak"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/materiakv"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/metabase"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/mongodb"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/mysql"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/networkgroup"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/networkgroup/member"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/networkgroup/peer"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/nodejs"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/php"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/postgresql"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/pulsar"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/pulsar/namespace"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/pulsar/topic"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/python"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/redis"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/ruby"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/rust"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/scala"
 	"go.clever-cloud.com/terraform-provider/pkg/resources/static"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/tcpredirection"
+	"go.clever-cloud.com/terraform-provider/pkg/resources/vhost"
 )
 
 var Datasources = []func() datasource.DataSource{}
@@ -26,16 +45,39 @@ var Resources = []func() resource.Resource{
 	addon.NewResourceAddon,
 	bucket.NewResourceCellarBucket,
 	cellar.NewResourceCellar,
+	elasticsearch.NewResourceElasticsearch,
 	java.NewResourceJava("war"),
+	java.NewResourceJava("jar"),
+	java.NewResourceJava("maven"),
+	java.NewResourceJava("gradle"),
+	java.NewResourceJava("play"),
 	materiakv.NewResourceMateriaKV,
 	metabase.NewResourceMetabase,
 	mongodb.NewResourceMongoDB,
+	mysql.NewResourceMySQL,
 	nodejs.NewResourceNodeJS,
 	php.NewResourcePHP,
 	postgresql.NewResourcePostgreSQL,
 	python.NewResourcePython,
+	pulsar.NewResourcePulsar,
+	topic.NewResourcePulsarTopic,
+	namespace.NewResourcePulsarNamespacePolicy,
+	redis.NewResourceRedis,
 	scala.NewResourceScala(),
 	static.NewResourceStatic(),
 	docker.NewResourceDocker,
 	keycloak.NewResourceKeycloak,
+	golang.NewResourceGo,
+	rust.NewResourceRust,
+	dotnet.NewResourceDotNet,
+	ruby.NewResourceRuby,
+	elixir.NewResourceElixir,
+	haskell.NewResourceHaskell,
+	vhost.NewResourceVHost,
+	certificate.NewResourceCertificate,
+	drain.NewResourceDrain,
+	tcpredirection.NewResourceTCPRedirection,
+	networkgroup.NewResourceNetworkgroup,
+	member.NewResourceNetworkgroupMember,
+	peer.NewResourceNetworkgroupExternalPeer,
 }
@@ -2,14 +2,22 @@ package addon
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
 
 type ResourceAddon struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceAddon() resource.Resource {
@@ -19,3 +27,80 @@ func NewResourceAddon() resource.Resource {
 func (r *ResourceAddon) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
 	res.TypeName = req.ProviderTypeName + "_addon"
 }
+
+// The provider is chosen by the user, it is empty on import and filled by Read
+func engineFor(providerID string) addon.Engine[*Addon, tmp.AddonResponse] {
+	return addon.Engine[*Addon, tmp.AddonResponse]{
+		ProviderID: providerID,
+		GetDetail:  tmp.GetAddon,
+		MapDetail: func(ad *Addon, detail *tmp.AddonResponse) {
+			ad.ThirdPartyProvider = pkg.FromStr(detail.Provider.ID)
+		},
+	}
+}
+
+// Configurations are exposed as the addon env
+func (r *ResourceAddon) readConfigurations(ctx context.Context, ad *Addon) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	envRes := tmp.GetAddonEnv(ctx, r.cc, r.org, ad.ID.ValueString())
+	if envRes.HasError() {
+		diags.AddError("failed to get addon env", envRes.Error().Error())
+		return diags
+	}
+
+	envAsMap := pkg.Reduce(*envRes.Payload(), map[string]attr.Value{}, func(acc map[string]attr.Value, v tmp.EnvVar) map[string]attr.Value {
+		acc[v.Name] = pkg.FromStr(v.Value)
+		return acc
+	})
+	ad.Configurations = types.MapValueMust(types.StringType, envAsMap)
+
+	return diags
+}
+
+// Options are not exposed by every provider, only the configured ones are refreshed
+func (r *ResourceAddon) readOptions(ctx context.Context, ad *Addon) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if ad.Options.IsNull() || ad.Options.IsUnknown() {
+		return diags
+	}
+
+	options := map[string]string{}
+	diags.Append(ad.Options.ElementsAs(ctx, &options, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	optionsRes := tmp.GetAddonOptions(ctx, r.cc, ad.ThirdPartyProvider.ValueString(), ad.ID.ValueString())
+	if optionsRes.IsNotFoundError() {
+		return diags
+	}
+	if optionsRes.HasError() {
+		diags.AddError("failed to get addon options", optionsRes.Error().Error())
+		return diags
+	}
+
+	remote := optionsRes.Payload()
+	for name := range options {
+		if name == addon.OptionVersion {
+			// keep the configured version while the remote one matches it
+			if remote.Version != "" && !addon.VersionMatches(options[name], remote.Version) {
+				options[name] = remote.Version
+			}
+			continue
+		}
+
+		feature := pkg.First(remote.Features, func(feature tmp.AddonFeature) bool {
+			return feature.Name == name
+		})
+		if feature != nil {
+			options[name] = strconv.FormatBool(feature.Enabled)
+		}
+	}
+
+	optionsValue, mapDiags := types.MapValueFrom(ctx, types.StringType, options)
+	diags.Append(mapDiags...)
+	ad.Options = optionsValue
+
+	return diags
+}
@@ -2,15 +2,13 @@ package addon
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 	"go.clever-cloud.com/terraform-provider/pkg/provider"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
@@ -29,9 +27,45 @@ func (r *ResourceAddon) Configure(ctx context.Context, req resource.ConfigureReq
 	if ok {
 		r.cc = provider.Client()
 		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
 	}
 }
 
+func (r *ResourceAddon) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+
+	// options are only sent on creation, any change replaces the addon
+	creation := req.State.Raw.IsNull() || len(res.RequiresReplace) > 0
+	if !creation || r.cc == nil {
+		return
+	}
+
+	ad := Addon{}
+	res.Diagnostics.Append(req.Plan.Get(ctx, &ad)...)
+	if res.Diagnostics.HasError() || ad.ThirdPartyProvider.IsUnknown() || ad.Options.IsNull() || ad.Options.IsUnknown() {
+		return
+	}
+	for _, option := range ad.Options.Elements() {
+		// checked at creation once known
+		if option.IsUnknown() {
+			return
+		}
+	}
+
+	options := map[string]string{}
+	res.Diagnostics.Append(ad.Options.ElementsAs(ctx, &options, false)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(addon.ValidateOptions(ctx, r.cc, ad.ThirdPartyProvider.ValueString(), options)...)
+}
+
 // Create a new resource
 func (r *ResourceAddon) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	ad := Addon{}
@@ -41,58 +75,53 @@ func (r *ResourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	addonsProvidersRes := tmp.GetAddonsProviders(ctx, r.cc)
-	if addonsProvidersRes.HasError() {
-		resp.Diagnostics.AddError("failed to get addon providers", addonsProvidersRes.Error().Error())
+	createTimeout, diags := ad.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	addonsProviders := addonsProvidersRes.Payload()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	provider := pkg.LookupAddonProvider(*addonsProviders, ad.ThirdPartyProvider.ValueString())
-	if provider == nil {
-		resp.Diagnostics.AddError("This provider does not exists", fmt.Sprintf("available providers are: %s", strings.Join(pkg.AddonProvidersAsList(*addonsProviders), ", ")))
-		return
+	options := map[string]string{}
+	if !ad.Options.IsNull() {
+		resp.Diagnostics.Append(ad.Options.ElementsAs(ctx, &options, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	plan := pkg.LookupProviderPlan(provider, ad.Plan.ValueString())
-	if plan == nil {
-		resp.Diagnostics.AddError("This plan does not exists", "available plans are: "+strings.Join(pkg.ProviderPlansAsList(provider), ", "))
+	resp.Diagnostics.Append(engineFor(ad.ThirdPartyProvider.ValueString()).Create(ctx, r.cc, r.org, &ad, options)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	addonReq := tmp.AddonRequest{
-		Name:       ad.Name.ValueString(),
-		Plan:       plan.ID,
-		ProviderID: provider.ID,
-		Region:     ad.Region.ValueString(),
-	}
+	tags, diags := pkg.TagsWithDefaults(ctx, ad.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	ad.TagsAll = pkg.FromSetString(tags)
+	resp.Diagnostics.Append(pkg.SyncAddonTags(ctx, r.cc, r.org, ad.ID.ValueString(), tags)...)
 
-	res := tmp.CreateAddon(ctx, r.cc, r.org, addonReq)
-	if res.HasError() {
-		resp.Diagnostics.AddError("failed to create addon", res.Error().Error())
+	// the addon exists from now on, save it so it is tainted on failure,
+	// attributes only known once it is active are null until then
+	resp.Diagnostics.Append(resp.State.Set(ctx, ad)...)
+	resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ad.ID = pkg.FromStr(res.Payload().ID)
-	ad.CreationDate = pkg.FromI(res.Payload().CreationDate)
-
-	envRes := tmp.GetAddonEnv(ctx, r.cc, r.org, res.Payload().ID)
-	if res.HasError() {
-		resp.Diagnostics.AddError("failed to get addon env", res.Error().Error())
+	// dependent apps must not be deployed while the addon is provisioning
+	resp.Diagnostics.Append(engineFor(ad.ThirdPartyProvider.ValueString()).WaitForActive(ctx, r.cc, r.org, &ad)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	envAsMap := pkg.Reduce(*envRes.Payload(), map[string]attr.Value{}, func(acc map[string]attr.Value, v tmp.EnvVar) map[string]attr.Value {
-		acc[v.Name] = pkg.FromStr(v.Value)
-		return acc
-	})
-	ad.Configurations = types.MapValueMust(types.StringType, envAsMap)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, ad)...)
+	resp.Diagnostics.Append(r.readConfigurations(ctx, &ad)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, ad)...)
 }
 
 // Read resource information
@@ -100,83 +129,130 @@ func (r *ResourceAddon) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, "Addon READ", map[string]interface{}{"request": req})
 
 	var ad Addon
-	diags := req.State.Get(ctx, &ad)
+	resp.Diagnostics.Append(req.State.Get(ctx, &ad)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := ad.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	addonRes := tmp.GetAddon(ctx, r.cc, r.org, ad.ID.ValueString())
-	if addonRes.IsNotFoundError() {
-		req.State.RemoveResource(ctx)
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	found, diags := engineFor(ad.ThirdPartyProvider.ValueString()).Read(ctx, r.cc, r.org, &ad)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if addonRes.HasError() {
-		resp.Diagnostics.AddError("failed to get addon", addonRes.Error().Error())
+	if !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
-	addonEnvRes := tmp.GetAddonEnv(ctx, r.cc, r.org, ad.ID.ValueString())
-	if addonEnvRes.HasError() {
-		resp.Diagnostics.AddError("failed to get addon env", addonEnvRes.Error().Error())
+	resp.Diagnostics.Append(r.readConfigurations(ctx, &ad)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	envAsMap := pkg.Reduce(*addonEnvRes.Payload(), map[string]attr.Value{}, func(acc map[string]attr.Value, v tmp.EnvVar) map[string]attr.Value {
-		acc[v.Name] = pkg.FromStr(v.Value)
-		return acc
-	})
-
-	a := addonRes.Payload()
-	ad.Name = pkg.FromStr(a.Name)
-	ad.Plan = pkg.FromStr(a.Plan.Slug)
-	ad.Region = pkg.FromStr(a.Region)
-	ad.ThirdPartyProvider = pkg.FromStr(a.Provider.ID)
-	ad.CreationDate = pkg.FromI(a.CreationDate)
-	ad.Configurations = types.MapValueMust(types.StringType, envAsMap)
+	resp.Diagnostics.Append(r.readOptions(ctx, &ad)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	/*addonPGRes := tmp.GetPostgreSQL(ctx, r.cc, pg.ID.ValueString())
-	if addonPGRes.IsNotFoundError() {
-		diags = resp.State.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	tagsRes := tmp.GetAddonTags(ctx, r.cc, r.org, ad.ID.ValueString())
+	if tagsRes.HasError() {
+		resp.Diagnostics.AddError("failed to get addon tags", tagsRes.Error().Error())
+		return
 	}
-	if addonPGRes.HasError() {
-		resp.Diagnostics.AddError("failed to get Postgres resource", addonPGRes.Error().Error())
-	}*/
 
-	diags = resp.State.Set(ctx, ad)
+	ad.TagsAll = pkg.FromSetString(*tagsRes.Payload())
+	ad.Tags, diags = pkg.TagsFromRemote(ctx, *tagsRes.Payload(), ad.Tags, r.defaultTags)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, ad)...)
 }
 
 // Update resource
 func (r *ResourceAddon) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// TODO
+	plan := Addon{}
+	state := Addon{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(engineFor(state.ThirdPartyProvider.ValueString()).Update(ctx, r.cc, r.org, &state, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a migration can move the addon, so configurations may have changed
+	resp.Diagnostics.Append(r.readConfigurations(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(pkg.SyncAddonTags(ctx, r.cc, r.org, plan.ID.ValueString(), tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 // Delete resource
 func (r *ResourceAddon) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var ad Addon
 
-	diags := req.State.Get(ctx, &ad)
-	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &ad)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Debug(ctx, "Addon DELETE", map[string]interface{}{"addon": ad})
 
-	res := tmp.DeleteAddon(ctx, r.cc, r.org, ad.ID.ValueString())
-	if res.IsNotFoundError() {
-		resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(ad.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := ad.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if res.HasError() {
-		resp.Diagnostics.AddError("failed to delete addon", res.Error().Error())
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Addon DELETE", map[string]interface{}{"ad": ad})
+
+	resp.Diagnostics.Append(engineFor(ad.ThirdPartyProvider.ValueString()).Delete(ctx, r.cc, r.org, &ad)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -6,6 +6,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
@@ -14,6 +17,7 @@ type Addon struct {
 	attributes.Addon
 	ThirdPartyProvider types.String `tfsdk:"third_party_provider"`
 	Configurations     types.Map    `tfsdk:"configurations"`
+	Options            types.Map    `tfsdk:"options"`
 }
 
 //go:embed doc.md
@@ -24,7 +28,17 @@ func (r ResourceAddon) Schema(_ context.Context, req resource.SchemaRequest, res
 		Version:             0,
 		MarkdownDescription: resourcePostgresqlDoc,
 		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
-			"third_party_provider": schema.StringAttribute{Required: true, MarkdownDescription: "Provider ID"},
+			"third_party_provider": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Provider ID",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"options": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Options sent at creation, like `version` or `encryption`, validated against the ones advertised by the provider",
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
 			// provider
 			"configurations": schema.MapAttribute{
 				Computed:            true,
@@ -33,6 +47,7 @@ func (r ResourceAddon) Schema(_ context.Context, req resource.SchemaRequest, res
 				ElementType:         types.StringType,
 			},
 		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
@@ -4,9 +4,11 @@ import (
 	"context"
 	_ "embed"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
 type CellarBucket struct {
@@ -15,6 +17,8 @@ type CellarBucket struct {
 	// TODO: Name instead of ID when issue is resolved
 	Name     types.String `tfsdk:"id"`
 	CellarID types.String `tfsdk:"cellar_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 //go:embed doc.md
@@ -29,6 +33,9 @@ func (r ResourceCellarBucket) Schema(_ context.Context, req resource.SchemaReque
 			"id":        schema.StringAttribute{Required: true, MarkdownDescription: "Name of the bucket"},
 			"cellar_id": schema.StringAttribute{Required: true, MarkdownDescription: "Cellar's reference"},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
 	}
 }
 
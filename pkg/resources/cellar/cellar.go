@@ -1,21 +1,34 @@
 package cellar
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"go.clever-cloud.dev/client"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/s3"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
 
 type ResourceCellar struct {
-	cc  *client.Client
-	org string
+	addon.Resource[Cellar, *Cellar, []tmp.EnvVar]
 }
 
 func NewResourceCellar() resource.Resource {
-	return &ResourceCellar{}
+	return &ResourceCellar{addon.Resource[Cellar, *Cellar, []tmp.EnvVar]{
+		Engine:   engine,
+		TypeName: "cellar",
+	}}
 }
 
-func (r *ResourceCellar) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_cellar"
+// Cellar has no status, its credentials are exposed by the addon env
+var engine = addon.Engine[*Cellar, []tmp.EnvVar]{
+	ProviderID: "cellar-addon",
+	UseRealID:  true,
+	GetDetail:  tmp.GetAddonEnv,
+	MapDetail: func(cellar *Cellar, env *[]tmp.EnvVar) {
+		creds := s3.FromEnvVars(*env)
+
+		cellar.Host = pkg.FromStr(creds.Host)
+		cellar.KeyID = pkg.FromStr(creds.KeyID)
+		cellar.KeySecret = pkg.FromStr(creds.KeySecret)
+	},
 }
@@ -4,23 +4,57 @@ import (
 	"context"
 	_ "embed"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
 type Cellar struct {
 	ID types.String `tfsdk:"id"`
 
-	Name   types.String `tfsdk:"name"`
-	Region types.String `tfsdk:"region"`
+	Name    types.String `tfsdk:"name"`
+	Region  types.String `tfsdk:"region"`
+	Tags    types.Set    `tfsdk:"tags"`
+	TagsAll types.Set    `tfsdk:"tags_all"`
 
 	Host      types.String `tfsdk:"host"`
 	KeyID     types.String `tfsdk:"key_id"`
 	KeySecret types.String `tfsdk:"key_secret"`
+
+	AdoptExisting      types.Bool `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ToAddon and FromAddon let the addon engine work on Cellar, which has no plan
+func (cellar Cellar) ToAddon() attributes.Addon {
+	return attributes.Addon{
+		ID:                 cellar.ID,
+		Name:               cellar.Name,
+		Region:             cellar.Region,
+		Tags:               cellar.Tags,
+		TagsAll:            cellar.TagsAll,
+		AdoptExisting:      cellar.AdoptExisting,
+		DeletionProtection: cellar.DeletionProtection,
+		Timeouts:           cellar.Timeouts,
+	}
+}
+
+func (cellar *Cellar) FromAddon(a attributes.Addon) {
+	cellar.ID = a.ID
+	cellar.Name = a.Name
+	cellar.Region = a.Region
+	cellar.Tags = a.Tags
+	cellar.TagsAll = a.TagsAll
+	cellar.AdoptExisting = a.AdoptExisting
+	cellar.DeletionProtection = a.DeletionProtection
+	cellar.Timeouts = a.Timeouts
 }
 
 //go:embed doc.md
@@ -32,12 +66,17 @@ func (r ResourceCellar) Schema(_ context.Context, req resource.SchemaRequest, re
 		MarkdownDescription: resourceCellarDoc,
 		Attributes: map[string]schema.Attribute{
 			// customer provided
-			"name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the Cellar"},
+			"name":                schema.StringAttribute{Required: true, MarkdownDescription: "Name of the Cellar"},
+			"adopt_existing":      attributes.AdoptExistingAttribute,
+			"deletion_protection": attributes.DeletionProtectionAttribute,
+			"tags":                attributes.AddonTagsAttribute,
+			"tags_all":            attributes.AddonTagsAllAttribute,
 			"region": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Geographical region where the data will be stored",
 				Default:             stringdefault.StaticString("par"),
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 
 			// provider
@@ -46,6 +85,7 @@ func (r ResourceCellar) Schema(_ context.Context, req resource.SchemaRequest, re
 			"key_id":     schema.StringAttribute{Computed: true, MarkdownDescription: "Key ID used to authenticate"},
 			"key_secret": schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Key secret used to authenticate"},
 		},
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
@@ -0,0 +1,21 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceCertificate struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceCertificate() resource.Resource {
+	return &ResourceCertificate{}
+}
+
+func (r *ResourceCertificate) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_certificate"
+}
@@ -0,0 +1,123 @@
+package certificate_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccCertificate_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-cert-%d", time.Now().UnixMilli())
+	domain := fmt.Sprintf("%s.example.com", rName)
+	fullName := fmt.Sprintf("clevercloud_certificate.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+
+	cert, key := selfSigned(t, domain)
+	_, otherKey := selfSigned(t, domain)
+
+	certificateBlock := helper.NewRessource(
+		"clevercloud_certificate",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"certificate": cert,
+			"private_key": key,
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(certificateBlock.SetOneValue("private_key", otherKey)).String(),
+			PlanOnly:     true,
+			ExpectError:  regexp.MustCompile(`private key does not match the certificate`),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(certificateBlock.SetOneValue("private_key", key)).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(fullName, "id"),
+				resource.TestCheckResourceAttr(fullName, "sans.#", "1"),
+				resource.TestCheckResourceAttr(fullName, "sans.0", domain),
+				resource.TestCheckResourceAttr(fullName, "issuer", "CN="+domain),
+				resource.TestCheckResourceAttrSet(fullName, "not_after"),
+			),
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				res := tmp.GetCertificate(ctx, cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				return fmt.Errorf("expect certificate '%s' to be deleted", resource.Primary.ID)
+			}
+			return nil
+		},
+	})
+}
+
+func selfSigned(t *testing.T, domain string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixMilli()),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+
+	// escape newlines to fit in a single line HCL string
+	toHCL := func(block *pem.Block) string {
+		return strings.ReplaceAll(string(pem.EncodeToMemory(block)), "\n", `\n`)
+	}
+
+	return toHCL(&pem.Block{Type: "CERTIFICATE", Bytes: der}), toHCL(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
@@ -0,0 +1,229 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// warn when the certificate expires within this delay
+const expirationWarningDelay = 30 * 24 * time.Hour
+
+func expiresSoon(notAfter, now time.Time) bool {
+	return notAfter.Sub(now) < expirationWarningDelay
+}
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceCertificate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceCertificate.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+func (r *ResourceCertificate) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := Certificate{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Certificate.IsUnknown() || config.Certificate.IsNull() {
+		return
+	}
+
+	if _, err := parseLeaf(config.Certificate.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(path.Root("certificate"), "invalid certificate", err.Error())
+		return
+	}
+
+	if config.PrivateKey.IsUnknown() || config.PrivateKey.IsNull() {
+		return
+	}
+
+	if err := checkKeyPair(config.Certificate.ValueString(), config.PrivateKey.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(path.Root("private_key"), "private key does not match the certificate", err.Error())
+	}
+}
+
+func (r *ResourceCertificate) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	plan := Certificate{}
+	state := Certificate{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() || plan.Certificate.IsUnknown() {
+		return
+	}
+
+	leaf, err := parseLeaf(plan.Certificate.ValueString())
+	if err != nil {
+		// already reported by ValidateConfig
+		return
+	}
+
+	notAfter := leaf.NotAfter.UTC().Format(time.RFC3339)
+	if expiresSoon(leaf.NotAfter, time.Now()) {
+		res.Diagnostics.AddAttributeWarning(
+			path.Root("certificate"),
+			"certificate is close to expiry",
+			fmt.Sprintf("certificate for '%s' expires on %s", leaf.Subject.CommonName, notAfter),
+		)
+	}
+
+	// an unchanged certificate keeps the values read from the API
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if res.Diagnostics.HasError() || state.Certificate.Equal(plan.Certificate) {
+			return
+		}
+	}
+
+	plan.SANs = pkg.FromListString(leaf.DNSNames)
+	plan.Issuer = pkg.FromStr(leaf.Issuer.String())
+	plan.NotAfter = pkg.FromStr(notAfter)
+
+	res.Diagnostics.Append(res.Plan.Set(ctx, plan)...)
+}
+
+// Create a new resource
+func (r *ResourceCertificate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := Certificate{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	res := tmp.CreateCertificate(ctx, r.cc, r.org, tmp.CertificateRequest{
+		PEM: toPEM(plan.Certificate.ValueString(), plan.PrivateKey.ValueString()),
+	})
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to upload certificate", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(res.Payload().ID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read resource information
+func (r *ResourceCertificate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Certificate READ", map[string]interface{}{"request": req})
+
+	var state Certificate
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res := tmp.GetCertificate(ctx, r.cc, r.org, state.ID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to get certificate", res.Error().Error())
+		return
+	}
+
+	certificate := res.Payload()
+	if len(certificate.Domains) > 0 {
+		state.SANs = pkg.FromListString(certificate.Domains)
+	}
+	if certificate.Issuer != "" {
+		state.Issuer = pkg.FromStr(certificate.Issuer)
+	}
+	if notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter); err == nil {
+		state.NotAfter = pkg.FromStr(notAfter.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceCertificate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := Certificate{}
+	state := Certificate{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// certificate and private_key both require a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourceCertificate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Certificate
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "CERTIFICATE DELETE", map[string]interface{}{"certificate": state.ID.ValueString()})
+
+	res := tmp.DeleteCertificate(ctx, r.cc, r.org, state.ID.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete certificate", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
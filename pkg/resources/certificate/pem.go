@@ -0,0 +1,33 @@
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// parseLeaf returns the first certificate of a PEM encoded chain
+func parseLeaf(chain string) (*x509.Certificate, error) {
+	block, rest := pem.Decode([]byte(chain))
+	for block != nil && block.Type != "CERTIFICATE" {
+		block, rest = pem.Decode(rest)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// checkKeyPair ensures the private key matches the leaf certificate
+func checkKeyPair(chain, key string) error {
+	_, err := tls.X509KeyPair([]byte(chain), []byte(key))
+	return err
+}
+
+// toPEM builds the payload expected by the API: chain followed by the key
+func toPEM(chain, key string) string {
+	return strings.TrimSpace(chain) + "\n" + strings.TrimSpace(key) + "\n"
+}
@@ -0,0 +1,96 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseLeaf(t *testing.T) {
+	leaf, _ := generate(t, "leaf.example.com")
+	intermediate, _ := generate(t, "intermediate.example.com")
+
+	cert, err := parseLeaf(leaf + intermediate)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cert.Subject.CommonName != "leaf.example.com" {
+		t.Errorf("expect leaf certificate first, got '%s'", cert.Subject.CommonName)
+	}
+
+	// a key before the chain is skipped
+	_, key := generate(t, "leaf.example.com")
+	if cert, err := parseLeaf(key + leaf); err != nil || cert.Subject.CommonName != "leaf.example.com" {
+		t.Errorf("expect leaf certificate after the key, got '%v' (%v)", cert, err)
+	}
+
+	if _, err := parseLeaf("not a certificate"); err == nil {
+		t.Errorf("expect an error without PEM block")
+	}
+	if _, err := parseLeaf(key); err == nil {
+		t.Errorf("expect an error without certificate block")
+	}
+}
+
+func TestCheckKeyPair(t *testing.T) {
+	cert, key := generate(t, "example.com")
+	_, otherKey := generate(t, "example.com")
+
+	if err := checkKeyPair(cert, key); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if err := checkKeyPair(cert, otherKey); err == nil {
+		t.Errorf("expect an error with a mismatching key")
+	}
+}
+
+func TestExpiresSoon(t *testing.T) {
+	now := time.Now()
+
+	cases := map[time.Duration]bool{
+		-time.Hour:                 true,
+		29 * 24 * time.Hour:        true,
+		expirationWarningDelay - 1: true,
+		expirationWarningDelay:     false,
+		90 * 24 * time.Hour:        false,
+	}
+	for remaining, expected := range cases {
+		if got := expiresSoon(now.Add(remaining), now); got != expected {
+			t.Errorf("expiring in %s: expect %t, got %t", remaining, expected, got)
+		}
+	}
+}
+
+func generate(t *testing.T, domain string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: domain},
+		DNSNames:     []string{domain},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(90 * 24 * time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %s", err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
@@ -0,0 +1,78 @@
+package certificate
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Certificate struct {
+	ID          types.String `tfsdk:"id"`
+	Certificate types.String `tfsdk:"certificate"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+
+	SANs     types.List   `tfsdk:"sans"`
+	Issuer   types.String `tfsdk:"issuer"`
+	NotAfter types.String `tfsdk:"not_after"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+//go:embed doc.md
+var resourceCertificateDoc string
+
+func (r ResourceCertificate) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceCertificateDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"certificate": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "PEM encoded certificate chain, leaf certificate first",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"private_key": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM encoded private key of the leaf certificate",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+
+			// provider provided
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Certificate identifier",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"sans": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Domains covered by the certificate (Subject Alternative Names)",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Issuer of the leaf certificate",
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiration date of the leaf certificate (RFC3339)",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceCertificate) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -8,8 +8,9 @@ import (
 )
 
 type ResourceDocker struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceDocker() resource.Resource {
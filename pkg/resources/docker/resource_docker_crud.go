@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 	"go.clever-cloud.com/terraform-provider/pkg/provider"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
@@ -27,11 +28,42 @@ func (r *ResourceDocker) Configure(ctx context.Context, req resource.ConfigureRe
 	if ok {
 		r.cc = provider.Client()
 		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
 	}
 
 	tflog.Debug(ctx, "AFTER CONFIGURED", map[string]interface{}{"cc": r.cc == nil, "org": r.org})
 }
 
+// Check the configuration does not contradict itself
+func (r *ResourceDocker) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := Docker{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(config.Validate()...)
+}
+
+// Check the plan against the product catalog
+func (r *ResourceDocker) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction or provider not configured yet
+	if req.Plan.Raw.IsNull() || r.cc == nil {
+		return
+	}
+
+	plan := Docker{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(application.ValidateRuntime(ctx, r.cc, "docker", "Docker", plan.Runtime)...)
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+}
+
 // Create a new resource
 func (r *ResourceDocker) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := Docker{}
@@ -41,7 +73,17 @@ func (r *ResourceDocker) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	instance := application.LookupInstance(ctx, r.cc, "docker", "Docker", resp.Diagnostics)
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "docker", "Docker", plan.TargetInstanceVersion())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -57,6 +99,13 @@ func (r *ResourceDocker) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
 	createAppReq := application.CreateReq{
 		Client:       r.cc,
 		Organization: r.org,
@@ -75,27 +124,36 @@ func (r *ResourceDocker) Create(ctx context.Context, req resource.CreateRequest,
 			StickySessions:  plan.StickySessions.ValueBool(),
 			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
 			Zone:            plan.Region.ValueString(),
-			CancelOnPush:    false,
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
 		},
 		Environment: environment,
 		VHosts:      vhosts,
 		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
 	}
 
 	createAppRes, diags := application.CreateApp(ctx, createAppReq)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// once created, the application is saved even on partial failure so it gets tainted
+	if createAppRes == nil {
 		return
 	}
 
 	tflog.Debug(ctx, "BUILD FLAVOR RES"+createAppRes.Application.BuildFlavor.Name, map[string]interface{}{})
 	plan.ID = pkg.FromStr(createAppRes.Application.ID)
+	plan.ResolvedInstanceVersion = pkg.FromStr(createAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(createAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(createAppRes.Application.WebhookSecret)
 	plan.DeployURL = pkg.FromStr(createAppRes.Application.DeployURL)
 	plan.VHost = pkg.FromStr(createAppRes.Application.Vhosts[0].Fqdn)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
-		return
+		// attributes left unknown by a partial failure are saved as null
+		resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
 	}
 }
 
@@ -108,6 +166,15 @@ func (r *ResourceDocker) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	app, diags := application.ReadApp(ctx, r.cc, r.org, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -118,6 +185,21 @@ func (r *ResourceDocker) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	state.ResolvedInstanceVersion = pkg.FromStr(app.App.Instance.Version)
+	state.CancelOnPush = pkg.FromBool(app.App.CancelOnPush)
+	state.Homogeneous = pkg.FromBool(app.App.Homogeneous)
+	state.SeparateBuild = pkg.FromBool(app.App.SeparateBuild)
+	state.Running = app.Running(state.Running)
+	state.WebhookURL = pkg.FromStr(app.App.WebhookURL)
+	state.WebhookSecret = pkg.FromStr(app.App.WebhookSecret)
+
+	state.TagsAll = pkg.FromSetString(app.Tags)
+	state.Tags, diags = pkg.TagsFromRemote(ctx, app.Tags, state.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state.Name = pkg.FromStr(app.App.Name)
 	state.Description = pkg.FromStr(app.App.Description)
 	state.MinInstanceCount = pkg.FromI(int64(app.App.Instance.MinInstances))
@@ -148,10 +230,7 @@ func (r *ResourceDocker) Read(ctx context.Context, req resource.ReadRequest, res
 		state.VHost = types.StringNull()
 	}
 
-	vhostsWithoutDefault := pkg.Filter(vhosts, func(vhost string) bool {
-		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
-		return !ok
-	})
+	vhostsWithoutDefault := application.TrackedVHosts(ctx, vhosts, state.AdditionalVHosts)
 	if len(vhostsWithoutDefault) > 0 {
 		state.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
 	} else {
@@ -163,7 +242,109 @@ func (r *ResourceDocker) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update resource
 func (r *ResourceDocker) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
-	// TODO
+	plan := Docker{}
+	state := Docker{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "docker", "Docker", plan.TargetInstanceVersion())
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	environment := plan.toEnv(ctx, res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	vhosts := []string{}
+	if res.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	updateAppReq := application.UpdateReq{
+		ID:           state.ID.ValueString(),
+		Client:       r.cc,
+		Organization: r.org,
+		Application: tmp.UpdateAppReq{
+			Name:            plan.Name.ValueString(),
+			Deploy:          "git",
+			Description:     plan.Description.ValueString(),
+			InstanceType:    instance.Type,
+			InstanceVariant: instance.Variant.ID,
+			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
+			MinFlavor:       plan.SmallestFlavor.ValueString(),
+			MaxFlavor:       plan.BiggestFlavor.ValueString(),
+			MinInstances:    plan.MinInstanceCount.ValueInt64(),
+			MaxInstances:    plan.MaxInstanceCount.ValueInt64(),
+			StickySessions:  plan.StickySessions.ValueBool(),
+			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
+			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
+		},
+		Environment: environment,
+		VHosts:      vhosts,
+		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
+	}
+
+	updateAppRes, diags := application.UpdateApp(ctx, updateAppReq)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.ResolvedInstanceVersion = pkg.FromStr(updateAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(updateAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(updateAppRes.Application.WebhookSecret)
+
+	hasDefaultVHost := pkg.HasSome(updateAppReq.VHosts, func(vhost string) bool {
+		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+	})
+	if hasDefaultVHost {
+		cleverapps := *pkg.First(vhosts, func(vhost string) bool {
+			return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		})
+		plan.VHost = pkg.FromStr(cleverapps)
+	} else {
+		plan.VHost = types.StringNull()
+	}
+
+	vhostsWithoutDefault := pkg.Filter(updateAppReq.VHosts, func(vhost string) bool {
+		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		return !ok
+	})
+	if len(vhostsWithoutDefault) > 0 {
+		plan.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
+	} else {
+		plan.AdditionalVHosts = types.ListNull(types.StringType)
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, plan)...)
 }
 
 // Delete resource
@@ -174,6 +355,21 @@ func (r *ResourceDocker) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "DOCKER DELETE", map[string]interface{}{"state": state})
 
 	res := tmp.DeleteApp(ctx, r.cc, r.org, state.ID.ValueString())
@@ -0,0 +1,22 @@
+package dotnet
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceDotNet struct {
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func NewResourceDotNet() resource.Resource {
+	return &ResourceDotNet{}
+}
+
+func (r *ResourceDotNet) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_dotnet"
+}
@@ -0,0 +1,105 @@
+package dotnet_test
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccDotNet_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-dotnet-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_dotnet.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	dotnetBlock := helper.NewRessource(
+		"clevercloud_dotnet",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 2,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "M",
+			"project":            "MyApp",
+			"target_framework":   "net8.0",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(dotnetBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*$`)),
+				resource.TestMatchResourceAttr(fullName, "deploy_url", regexp.MustCompile(`^git\+ssh.*\.git$`)),
+				resource.TestCheckResourceAttr(fullName, "region", "par"),
+				resource.TestCheckResourceAttr(fullName, "project", "MyApp"),
+				resource.TestCheckResourceAttr(fullName, "target_framework", "net8.0"),
+				func(state *terraform.State) error {
+					id := state.RootModule().Resources[fullName].Primary.ID
+
+					appEnvRes := tmp.GetAppEnv(ctx, cc, org, id)
+					if appEnvRes.HasError() {
+						return fmt.Errorf("failed to get application env: %w", appEnvRes.Error())
+					}
+
+					env := pkg.Reduce(*appEnvRes.Payload(), map[string]string{}, func(acc map[string]string, e tmp.Env) map[string]string {
+						acc[e.Name] = e.Value
+						return acc
+					})
+
+					if env["CC_DOTNET_PROJ"] != "MyApp" {
+						return fmt.Errorf("bad env var value CC_DOTNET_PROJ, got: '%s', expect: 'MyApp'", env["CC_DOTNET_PROJ"])
+					}
+					if env["CC_DOTNET_TFM"] != "net8.0" {
+						return fmt.Errorf("bad env var value CC_DOTNET_TFM, got: '%s', expect: 'net8.0'", env["CC_DOTNET_TFM"])
+					}
+					return nil
+				},
+			),
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				res := tmp.GetApp(ctx, cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+				if res.Payload().State == "TO_DELETE" {
+					continue
+				}
+
+				return fmt.Errorf("expect resource '%s' to be deleted state: '%s'", resource.Primary.ID, res.Payload().State)
+			}
+			return nil
+		},
+	})
+}
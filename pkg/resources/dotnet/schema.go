@@ -0,0 +1,79 @@
+package dotnet
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type DotNet struct {
+	attributes.Runtime
+	Project         types.String `tfsdk:"project"`
+	TargetFramework types.String `tfsdk:"target_framework"`
+}
+
+//go:embed doc.md
+var dotnetDoc string
+
+func (r ResourceDotNet) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: dotnetDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// CC_DOTNET_PROJ
+			"project": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name of the project file to build, without the extension",
+			},
+			// CC_DOTNET_TFM
+			"target_framework": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Target framework moniker to build against (e.g. `net8.0`)",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (dn *DotNet) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (dn *DotNet) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(dn.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(dn.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(dn.Project, func(s string) { env["CC_DOTNET_PROJ"] = s })
+	pkg.IfIsSet(dn.TargetFramework, func(s string) { env["CC_DOTNET_TFM"] = s })
+	env = pkg.Merge(env, dn.Hooks.ToEnv())
+
+	return env
+}
+
+func (dn *DotNet) toDeployment() *application.Deployment {
+	if dn.Deployment == nil || dn.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: dn.Deployment.Repository.ValueString(),
+		Commit:     dn.Deployment.Commit.ValueStringPointer(),
+	}
+}
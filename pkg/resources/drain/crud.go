@@ -0,0 +1,249 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceDrain) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceDrain.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+func (r *ResourceDrain) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := Drain{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() || config.Type.IsUnknown() {
+		return
+	}
+
+	drainType := config.Type.ValueString()
+
+	if drainType == TypeNewRelicHTTP && config.APIKey.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root("api_key"), "missing api_key", "NewRelicHTTP drains require an API key")
+	}
+	if drainType != TypeElasticSearch && !config.IndexPrefix.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root("index_prefix"), "unexpected index_prefix", "index_prefix is only supported by ElasticSearch drains")
+	}
+	if drainType != TypeUDPSyslog && drainType != TypeTCPSyslog && !config.StructuredData.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root("structured_data_parameters"), "unexpected structured_data_parameters", "structured_data_parameters is only supported by syslog drains")
+	}
+	if config.Username.IsNull() != config.Password.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root("password"), "incomplete credentials", "username and password must be set together")
+	}
+}
+
+// Create a new resource
+func (r *ResourceDrain) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := Drain{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	drainReq := tmp.DrainRequest{
+		URL:                      plan.URL.ValueString(),
+		DrainType:                plan.Type.ValueString(),
+		APIKey:                   plan.APIKey.ValueString(),
+		IndexPrefix:              plan.IndexPrefix.ValueString(),
+		StructuredDataParameters: plan.StructuredData.ValueString(),
+	}
+	if !plan.Username.IsNull() {
+		drainReq.Credentials = &tmp.DrainCredentials{
+			Username: plan.Username.ValueString(),
+			Password: plan.Password.ValueString(),
+		}
+	}
+
+	res := tmp.CreateDrain(ctx, r.cc, plan.ResourceID.ValueString(), drainReq)
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to create drain", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(res.Payload().ID)
+	if plan.IndexPrefix.IsUnknown() {
+		plan.IndexPrefix = pkg.FromStr(res.Payload().IndexPrefix)
+	}
+	if plan.StructuredData.IsUnknown() {
+		plan.StructuredData = pkg.FromStr(res.Payload().StructuredDataParameters)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// drains are enabled on creation
+	if !plan.Enabled.ValueBool() {
+		resp.Diagnostics.Append(r.setEnabled(ctx, plan)...)
+	}
+}
+
+// Read resource information
+func (r *ResourceDrain) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Drain READ", map[string]interface{}{"request": req})
+
+	var state Drain
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res := tmp.ListDrains(ctx, r.cc, state.ResourceID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to get drains", res.Error().Error())
+		return
+	}
+
+	drain := pkg.First(*res.Payload(), func(drain tmp.Drain) bool {
+		return drain.ID == state.ID.ValueString()
+	})
+	if drain == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Type = pkg.FromStr(drain.DrainType)
+	state.URL = pkg.FromStr(drain.URL)
+	state.Enabled = pkg.FromBool(drain.State == "ENABLED")
+	if drain.Credentials.Username != "" {
+		state.Username = pkg.FromStr(drain.Credentials.Username)
+	}
+	if drain.IndexPrefix != "" {
+		state.IndexPrefix = pkg.FromStr(drain.IndexPrefix)
+	}
+	if drain.StructuredDataParameters != "" {
+		state.StructuredData = pkg.FromStr(drain.StructuredDataParameters)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceDrain) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := Drain{}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// every other attribute requires a replacement
+	resp.Diagnostics.Append(r.setEnabled(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete resource
+func (r *ResourceDrain) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Drain
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "DRAIN DELETE", map[string]interface{}{"drain": state.ID.ValueString()})
+
+	res := tmp.DeleteDrain(ctx, r.cc, state.ResourceID.ValueString(), state.ID.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete drain", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourceDrain) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resourceID, drainID, ok := strings.Cut(req.ID, "/")
+	if !ok || resourceID == "" || drainID == "" {
+		resp.Diagnostics.AddError("invalid import identifier", fmt.Sprintf("expect 'resource_id/drain_id', got '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), drainID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), resourceID)...)
+}
+
+func (r *ResourceDrain) setEnabled(ctx context.Context, drain Drain) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	state := "DISABLED"
+	if drain.Enabled.ValueBool() {
+		state = "ENABLED"
+	}
+
+	res := tmp.UpdateDrainState(ctx, r.cc, drain.ResourceID.ValueString(), drain.ID.ValueString(), state)
+	if res.HasError() {
+		diags.AddError("failed to update drain state", res.Error().Error())
+	}
+
+	return diags
+}
@@ -0,0 +1,21 @@
+package drain
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceDrain struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceDrain() resource.Resource {
+	return &ResourceDrain{}
+}
+
+func (r *ResourceDrain) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_drain"
+}
@@ -0,0 +1,106 @@
+package drain_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccDrain_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-drain-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_drain.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	phpBlock := helper.NewRessource(
+		"clevercloud_php",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 1,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "XS",
+		}))
+	drainBlock := helper.NewRessource(
+		"clevercloud_drain",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"resource_id": fmt.Sprintf("${clevercloud_php.%s.id}", rName),
+			"type":        "HTTP",
+			"url":         "https://logs.example.com/ingest",
+			"username":    "user",
+			"password":    "secret",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock, drainBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet(fullName, "id"),
+				resource.TestCheckResourceAttr(fullName, "type", "HTTP"),
+				resource.TestCheckResourceAttr(fullName, "enabled", "true"),
+			),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock, drainBlock.SetOneValue("enabled", false)).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(fullName, "enabled", "false"),
+			),
+		}, {
+			ResourceName: fullName,
+			ImportState:  true,
+			ImportStateIdFunc: func(state *terraform.State) (string, error) {
+				drain := state.RootModule().Resources[fullName].Primary
+				return drain.Attributes["resource_id"] + "/" + drain.ID, nil
+			},
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"password"},
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				if resource.Type != "clevercloud_drain" {
+					continue
+				}
+
+				res := tmp.ListDrains(ctx, cc, resource.Primary.Attributes["resource_id"])
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				if pkg.HasSome(*res.Payload(), func(drain tmp.Drain) bool { return drain.ID == resource.Primary.ID }) {
+					return fmt.Errorf("expect drain '%s' to be deleted", resource.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
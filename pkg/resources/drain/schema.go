@@ -0,0 +1,143 @@
+package drain
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Drain struct {
+	ID             types.String `tfsdk:"id"`
+	ResourceID     types.String `tfsdk:"resource_id"`
+	Type           types.String `tfsdk:"type"`
+	URL            types.String `tfsdk:"url"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	APIKey         types.String `tfsdk:"api_key"`
+	IndexPrefix    types.String `tfsdk:"index_prefix"`
+	StructuredData types.String `tfsdk:"structured_data_parameters"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+const (
+	TypeUDPSyslog     = "UDPSyslog"
+	TypeTCPSyslog     = "TCPSyslog"
+	TypeHTTP          = "HTTP"
+	TypeElasticSearch = "ElasticSearch"
+	TypeDatadogHTTP   = "DatadogHTTP"
+	TypeNewRelicHTTP  = "NewRelicHTTP"
+)
+
+var drainTypes = []string{TypeUDPSyslog, TypeTCPSyslog, TypeHTTP, TypeElasticSearch, TypeDatadogHTTP, TypeNewRelicHTTP}
+
+//go:embed doc.md
+var resourceDrainDoc string
+
+func (r ResourceDrain) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	// the API may fill these in, keep its value while the configuration leaves them unset
+	apiDefaulted := []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceDrainDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"resource_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Application or addon whose logs are sent",
+				PlanModifiers:       requiresReplace,
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Drain type: `UDPSyslog`, `TCPSyslog`, `HTTP`, `ElasticSearch`, `DatadogHTTP` or `NewRelicHTTP`",
+				PlanModifiers:       requiresReplace,
+				Validators: []validator.String{
+					pkg.NewValidator("type must be a known drain type", func(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+						if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+							return
+						}
+
+						if !slices.Contains(drainTypes, req.ConfigValue.ValueString()) {
+							res.Diagnostics.AddAttributeError(
+								req.Path,
+								"invalid drain type",
+								fmt.Sprintf("expect one of %s, got '%s'", strings.Join(drainTypes, ", "), req.ConfigValue.ValueString()),
+							)
+						}
+					}),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Target URL, e.g. `udp://logs.example.com:514` or `https://es.example.com`",
+				PlanModifiers:       requiresReplace,
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Basic auth user, for `HTTP` and `ElasticSearch` drains",
+				PlanModifiers:       requiresReplace,
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Basic auth password, for `HTTP` and `ElasticSearch` drains",
+				PlanModifiers:       requiresReplace,
+			},
+			"api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "API key, required for `NewRelicHTTP` drains",
+				PlanModifiers:       requiresReplace,
+			},
+			"index_prefix": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Index prefix, for `ElasticSearch` drains (default: `logstash-`)",
+				PlanModifiers:       apiDefaulted,
+			},
+			"structured_data_parameters": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "RFC5424 structured data, for syslog drains",
+				PlanModifiers:       apiDefaulted,
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Send logs to the drain",
+			},
+
+			// provider provided
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Drain identifier",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceDrain) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
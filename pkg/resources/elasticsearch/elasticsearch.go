@@ -0,0 +1,77 @@
+package elasticsearch
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceElasticsearch struct {
+	addon.Resource[Elasticsearch, *Elasticsearch, tmp.Elasticsearch]
+}
+
+func NewResourceElasticsearch() resource.Resource {
+	return &ResourceElasticsearch{addon.Resource[Elasticsearch, *Elasticsearch, tmp.Elasticsearch]{
+		Engine:   engine,
+		TypeName: "elasticsearch",
+		Options:  (*Elasticsearch).options,
+	}}
+}
+
+var engine = addon.Engine[*Elasticsearch, tmp.Elasticsearch]{
+	ProviderID: "es-addon",
+	GetDetail: func(ctx context.Context, cc *client.Client, _, addonID string) client.Response[tmp.Elasticsearch] {
+		return tmp.GetElasticsearch(ctx, cc, addonID)
+	},
+	Status: func(detail *tmp.Elasticsearch) string { return detail.Status },
+	MapDetail: func(es *Elasticsearch, detail *tmp.Elasticsearch) {
+		es.Host = pkg.FromStr(detail.Host)
+		es.User = pkg.FromStr(detail.User)
+		es.Password = pkg.FromStr(detail.Password)
+		es.Version = addon.Version(es.Version, detail.Version)
+		es.Kibana = pkg.FromBool(addon.FeatureEnabled(detail.Services, addon.OptionKibana))
+		es.APM = pkg.FromBool(addon.FeatureEnabled(detail.Services, addon.OptionAPM))
+		es.KibanaAppID = pkg.FromStr(detail.KibanaApplication)
+		es.APMAppID = pkg.FromStr(detail.APMApplication)
+	},
+	Refresh: readApplications,
+}
+
+// Kibana and APM run as applications, their URLs are the applications vhosts
+func readApplications(ctx context.Context, cc *client.Client, organisation string, es *Elasticsearch) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	es.KibanaURL, diags = applicationURL(ctx, cc, organisation, es.KibanaAppID)
+	if diags.HasError() {
+		return diags
+	}
+
+	es.APMURL, diags = applicationURL(ctx, cc, organisation, es.APMAppID)
+	return diags
+}
+
+func applicationURL(ctx context.Context, cc *client.Client, organisation string, appID types.String) (types.String, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+	if appID.IsNull() {
+		return types.StringNull(), diags
+	}
+
+	vhostsRes := tmp.GetAppVHosts(ctx, cc, organisation, appID.ValueString())
+	if vhostsRes.HasError() {
+		diags.AddError("failed to get application vhosts", vhostsRes.Error().Error())
+		return types.StringNull(), diags
+	}
+
+	vhosts := *vhostsRes.Payload()
+	if len(vhosts) == 0 {
+		return types.StringNull(), diags
+	}
+
+	return pkg.FromStr("https://" + vhosts[0].Fqdn), diags
+}
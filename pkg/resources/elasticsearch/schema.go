@@ -0,0 +1,88 @@
+package elasticsearch
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Elasticsearch struct {
+	attributes.Addon
+	Host        types.String `tfsdk:"host"`
+	User        types.String `tfsdk:"user"`
+	Password    types.String `tfsdk:"password"`
+	KibanaAppID types.String `tfsdk:"kibana_app_id"`
+	KibanaURL   types.String `tfsdk:"kibana_url"`
+	APMAppID    types.String `tfsdk:"apm_app_id"`
+	APMURL      types.String `tfsdk:"apm_url"`
+
+	// Options
+	Version types.String `tfsdk:"version"`
+	Kibana  types.Bool   `tfsdk:"kibana"`
+	APM     types.Bool   `tfsdk:"apm"`
+}
+
+//go:embed doc.md
+var resourceElasticsearchDoc string
+
+func (r ResourceElasticsearch) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceElasticsearchDoc,
+		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
+			"version": attributes.VersionAttribute,
+			"kibana": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Deploy a Kibana application along the cluster",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"apm": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Deploy an APM server application along the cluster",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"host":          schema.StringAttribute{Computed: true, MarkdownDescription: "Cluster host, used to connect to"},
+			"user":          schema.StringAttribute{Computed: true, MarkdownDescription: "Login username"},
+			"password":      schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Login password"},
+			"kibana_app_id": schema.StringAttribute{Computed: true, MarkdownDescription: "ID of the Kibana application, when enabled"},
+			"kibana_url":    schema.StringAttribute{Computed: true, MarkdownDescription: "URL to access Kibana, when enabled"},
+			"apm_app_id":    schema.StringAttribute{Computed: true, MarkdownDescription: "ID of the APM server application, when enabled"},
+			"apm_url":       schema.StringAttribute{Computed: true, MarkdownDescription: "URL of the APM server, when enabled"},
+		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
+	}
+}
+
+func (es Elasticsearch) options() map[string]string {
+	options := map[string]string{}
+
+	pkg.IfIsSet(es.Version, func(version string) {
+		options[addon.OptionVersion] = version
+	})
+	if es.Kibana.ValueBool() {
+		options[addon.OptionKibana] = "true"
+	}
+	if es.APM.ValueBool() {
+		options[addon.OptionAPM] = "true"
+	}
+
+	return options
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceElasticsearch) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
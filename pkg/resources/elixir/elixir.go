@@ -0,0 +1,22 @@
+package elixir
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceElixir struct {
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func NewResourceElixir() resource.Resource {
+	return &ResourceElixir{}
+}
+
+func (r *ResourceElixir) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_elixir"
+}
@@ -0,0 +1,110 @@
+package elixir_test
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccElixir_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-elixir-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_elixir.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	elixirBlock := helper.NewRessource(
+		"clevercloud_elixir",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":                rName,
+			"region":              "par",
+			"min_instance_count":  1,
+			"max_instance_count":  2,
+			"smallest_flavor":     "XS",
+			"biggest_flavor":      "M",
+			"mix_env":             "prod",
+			"phoenix_assets_dir":  "assets",
+			"phoenix_server_goal": "phx.server --no-halt",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(elixirBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*$`)),
+				resource.TestMatchResourceAttr(fullName, "deploy_url", regexp.MustCompile(`^git\+ssh.*\.git$`)),
+				resource.TestCheckResourceAttr(fullName, "region", "par"),
+				resource.TestCheckResourceAttr(fullName, "mix_env", "prod"),
+				resource.TestCheckResourceAttr(fullName, "phoenix_assets_dir", "assets"),
+				resource.TestCheckResourceAttr(fullName, "phoenix_server_goal", "phx.server --no-halt"),
+				func(state *terraform.State) error {
+					id := state.RootModule().Resources[fullName].Primary.ID
+
+					appEnvRes := tmp.GetAppEnv(ctx, cc, org, id)
+					if appEnvRes.HasError() {
+						return fmt.Errorf("failed to get application env: %w", appEnvRes.Error())
+					}
+
+					env := pkg.Reduce(*appEnvRes.Payload(), map[string]string{}, func(acc map[string]string, e tmp.Env) map[string]string {
+						acc[e.Name] = e.Value
+						return acc
+					})
+
+					if env["MIX_ENV"] != "prod" {
+						return fmt.Errorf("bad env var value MIX_ENV, got: '%s', expect: 'prod'", env["MIX_ENV"])
+					}
+					if env["CC_PHOENIX_ASSETS_DIR"] != "assets" {
+						return fmt.Errorf("bad env var value CC_PHOENIX_ASSETS_DIR, got: '%s', expect: 'assets'", env["CC_PHOENIX_ASSETS_DIR"])
+					}
+					if env["CC_PHOENIX_SERVER_GOAL"] != "phx.server --no-halt" {
+						return fmt.Errorf("bad env var value CC_PHOENIX_SERVER_GOAL, got: '%s', expect: 'phx.server --no-halt'", env["CC_PHOENIX_SERVER_GOAL"])
+					}
+					return nil
+				},
+			),
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				res := tmp.GetApp(ctx, cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+				if res.Payload().State == "TO_DELETE" {
+					continue
+				}
+
+				return fmt.Errorf("expect resource '%s' to be deleted state: '%s'", resource.Primary.ID, res.Payload().State)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,86 @@
+package elixir
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Elixir struct {
+	attributes.Runtime
+	MixEnv            types.String `tfsdk:"mix_env"`
+	PhoenixAssetsDir  types.String `tfsdk:"phoenix_assets_dir"`
+	PhoenixServerGoal types.String `tfsdk:"phoenix_server_goal"`
+}
+
+//go:embed doc.md
+var elixirDoc string
+
+func (r ResourceElixir) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: elixirDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// MIX_ENV
+			"mix_env": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Mix environment (default: `prod`)",
+			},
+			// CC_PHOENIX_ASSETS_DIR
+			"phoenix_assets_dir": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Folder containing the Phoenix assets (default: `assets`)",
+			},
+			// CC_PHOENIX_SERVER_GOAL
+			"phoenix_server_goal": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Mix task and flags used to start the Phoenix server (default: `phx.server`)",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (ex *Elixir) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (ex *Elixir) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(ex.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(ex.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(ex.MixEnv, func(s string) { env["MIX_ENV"] = s })
+	pkg.IfIsSet(ex.PhoenixAssetsDir, func(s string) { env["CC_PHOENIX_ASSETS_DIR"] = s })
+	pkg.IfIsSet(ex.PhoenixServerGoal, func(s string) { env["CC_PHOENIX_SERVER_GOAL"] = s })
+	env = pkg.Merge(env, ex.Hooks.ToEnv())
+
+	return env
+}
+
+func (ex *Elixir) toDeployment() *application.Deployment {
+	if ex.Deployment == nil || ex.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: ex.Deployment.Repository.ValueString(),
+		Commit:     ex.Deployment.Commit.ValueStringPointer(),
+	}
+}
@@ -0,0 +1,424 @@
+package golang
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceGo) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceGo.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
+	}
+
+	tflog.Debug(ctx, "AFTER CONFIGURED", map[string]interface{}{"cc": r.cc == nil, "org": r.org})
+}
+
+// Check the configuration does not contradict itself
+func (r *ResourceGo) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := Go{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(config.Validate()...)
+}
+
+// Check the plan against the product catalog
+func (r *ResourceGo) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction or provider not configured yet
+	if req.Plan.Raw.IsNull() || r.cc == nil {
+		return
+	}
+
+	plan := Go{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(application.ValidateRuntime(ctx, r.cc, "go", "Go", plan.Runtime)...)
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+}
+
+// Create a new resource
+func (r *ResourceGo) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "ResourceGo.Create()")
+	plan := helper.PlanFrom[Go](ctx, req.Plan, resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "go", "Go", plan.TargetInstanceVersion())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vhosts := []string{}
+	resp.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environment := plan.toEnv(ctx, resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dependencies := []string{}
+	resp.Diagnostics.Append(plan.Dependencies.ElementsAs(ctx, &dependencies, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	createAppReq := application.CreateReq{
+		Client:       r.cc,
+		Organization: r.org,
+		Application: tmp.CreateAppRequest{
+			Name:            plan.Name.ValueString(),
+			Deploy:          "git",
+			Description:     plan.Description.ValueString(),
+			InstanceType:    instance.Type,
+			InstanceVariant: instance.Variant.ID,
+			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
+			MinFlavor:       plan.SmallestFlavor.ValueString(),
+			MaxFlavor:       plan.BiggestFlavor.ValueString(),
+			MinInstances:    plan.MinInstanceCount.ValueInt64(),
+			MaxInstances:    plan.MaxInstanceCount.ValueInt64(),
+			StickySessions:  plan.StickySessions.ValueBool(),
+			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
+			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
+		},
+		Environment:  environment,
+		VHosts:       vhosts,
+		Deployment:   plan.toDeployment(),
+		Dependencies: dependencies,
+		Tags:         tags,
+		Running:      plan.Running.ValueBool(),
+	}
+
+	createAppRes, diags := application.CreateApp(ctx, createAppReq)
+	resp.Diagnostics.Append(diags...)
+	// once created, the application is saved even on partial failure so it gets tainted
+	if createAppRes == nil {
+		return
+	}
+
+	plan.ID = pkg.FromStr(createAppRes.Application.ID)
+	plan.ResolvedInstanceVersion = pkg.FromStr(createAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(createAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(createAppRes.Application.WebhookSecret)
+	plan.DeployURL = pkg.FromStr(createAppRes.Application.DeployURL)
+	plan.VHost = pkg.FromStr(createAppRes.Application.Vhosts[0].Fqdn)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		// attributes left unknown by a partial failure are saved as null
+		resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
+	}
+}
+
+// Read resource information
+func (r *ResourceGo) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "ResourceGo.Read()")
+	state := helper.StateFrom[Go](ctx, req.State, resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	app, diags := application.ReadApp(ctx, r.cc, r.org, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if app.AppIsDeleted {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ResolvedInstanceVersion = pkg.FromStr(app.App.Instance.Version)
+	state.CancelOnPush = pkg.FromBool(app.App.CancelOnPush)
+	state.Homogeneous = pkg.FromBool(app.App.Homogeneous)
+	state.SeparateBuild = pkg.FromBool(app.App.SeparateBuild)
+	state.Running = app.Running(state.Running)
+	state.WebhookURL = pkg.FromStr(app.App.WebhookURL)
+	state.WebhookSecret = pkg.FromStr(app.App.WebhookSecret)
+
+	state.TagsAll = pkg.FromSetString(app.Tags)
+	state.Tags, diags = pkg.TagsFromRemote(ctx, app.Tags, state.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Name = pkg.FromStr(app.App.Name)
+	state.Description = pkg.FromStr(app.App.Description)
+	state.MinInstanceCount = pkg.FromI(int64(app.App.Instance.MinInstances))
+	state.MaxInstanceCount = pkg.FromI(int64(app.App.Instance.MaxInstances))
+	state.SmallestFlavor = pkg.FromStr(app.App.Instance.MinFlavor.Name)
+	state.BiggestFlavor = pkg.FromStr(app.App.Instance.MaxFlavor.Name)
+	state.Region = pkg.FromStr(app.App.Zone)
+	state.DeployURL = pkg.FromStr(app.App.DeployURL)
+
+	if app.App.SeparateBuild {
+		state.BuildFlavor = pkg.FromStr(app.App.BuildFlavor.Name)
+	} else {
+		state.BuildFlavor = types.StringNull()
+	}
+
+	vhosts := pkg.Map(app.App.Vhosts, func(vhost tmp.Vhost) string {
+		return vhost.Fqdn
+	})
+	hasDefaultVHost := pkg.HasSome(vhosts, func(vhost string) bool {
+		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+	})
+	if hasDefaultVHost {
+		cleverapps := *pkg.First(vhosts, func(vhost string) bool {
+			return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		})
+		state.VHost = pkg.FromStr(cleverapps)
+	} else {
+		state.VHost = types.StringNull()
+	}
+
+	vhostsWithoutDefault := application.TrackedVHosts(ctx, vhosts, state.AdditionalVHosts)
+	if len(vhostsWithoutDefault) > 0 {
+		state.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
+	} else {
+		state.AdditionalVHosts = types.ListNull(types.StringType)
+	}
+
+	for envName, envValue := range app.EnvAsMap() {
+		switch envName {
+		case "APP_FOLDER":
+			state.AppFolder = pkg.FromStr(envValue)
+		case "CC_GO_BUILD_TOOL":
+			state.BuildTool = pkg.FromStr(envValue)
+		case "CC_GO_PKG":
+			state.GoPackage = pkg.FromStr(envValue)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceGo) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	tflog.Debug(ctx, "ResourceGo.Update()")
+
+	plan := helper.PlanFrom[Go](ctx, req.Plan, res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	state := helper.StateFrom[Go](ctx, req.State, res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "go", "Go", plan.TargetInstanceVersion())
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	environment := plan.toEnv(ctx, res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	vhosts := []string{}
+	if res.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	dependencies := []string{}
+	if res.Diagnostics.Append(plan.Dependencies.ElementsAs(ctx, &dependencies, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	updateAppReq := application.UpdateReq{
+		ID:           state.ID.ValueString(),
+		Client:       r.cc,
+		Organization: r.org,
+		Application: tmp.UpdateAppReq{
+			Name:            plan.Name.ValueString(),
+			Deploy:          "git",
+			Description:     plan.Description.ValueString(),
+			InstanceType:    instance.Type,
+			InstanceVariant: instance.Variant.ID,
+			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
+			MinFlavor:       plan.SmallestFlavor.ValueString(),
+			MaxFlavor:       plan.BiggestFlavor.ValueString(),
+			MinInstances:    plan.MinInstanceCount.ValueInt64(),
+			MaxInstances:    plan.MaxInstanceCount.ValueInt64(),
+			StickySessions:  plan.StickySessions.ValueBool(),
+			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
+			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
+		},
+		Environment:  environment,
+		VHosts:       vhosts,
+		Deployment:   plan.toDeployment(),
+		Dependencies: dependencies,
+		Tags:         tags,
+		Running:      plan.Running.ValueBool(),
+	}
+
+	updateAppRes, diags := application.UpdateApp(ctx, updateAppReq)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.ResolvedInstanceVersion = pkg.FromStr(updateAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(updateAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(updateAppRes.Application.WebhookSecret)
+
+	hasDefaultVHost := pkg.HasSome(updateAppReq.VHosts, func(vhost string) bool {
+		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+	})
+	if hasDefaultVHost {
+		cleverapps := *pkg.First(vhosts, func(vhost string) bool {
+			return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		})
+		plan.VHost = pkg.FromStr(cleverapps)
+	} else {
+		plan.VHost = types.StringNull()
+	}
+
+	vhostsWithoutDefault := pkg.Filter(updateAppReq.VHosts, func(vhost string) bool {
+		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		return !ok
+	})
+	if len(vhostsWithoutDefault) > 0 {
+		plan.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
+	} else {
+		plan.AdditionalVHosts = types.ListNull(types.StringType)
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete resource
+func (r *ResourceGo) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "ResourceGo.Delete()")
+	var state Go
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "GO DELETE", map[string]interface{}{"state": state})
+
+	res := tmp.DeleteApp(ctx, r.cc, r.org, state.ID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to delete app", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourceGo) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "ResourceGo.ImportState()")
+	// Save the import identifier in the id attribute
+	// and call Read() to fill fields
+	attr := path.Root("id")
+	resource.ImportStatePassthroughID(ctx, attr, req, resp)
+}
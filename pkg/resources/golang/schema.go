@@ -0,0 +1,100 @@
+package golang
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Go struct {
+	attributes.Runtime
+	BuildTool types.String `tfsdk:"build_tool"`
+	GoPackage types.String `tfsdk:"go_package"`
+}
+
+var buildTools = []string{"gomod", "gobuild", "goget"}
+
+//go:embed doc.md
+var goDoc string
+
+func (r ResourceGo) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: goDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// CC_GO_BUILD_TOOL
+			"build_tool": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Build tool used to build the application: `gomod`, `gobuild` or `goget` (default: `goget`)",
+				Validators: []validator.String{
+					pkg.NewValidator("build tool must be one of gomod, gobuild or goget", func(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+						if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+							return
+						}
+
+						if !slices.Contains(buildTools, req.ConfigValue.ValueString()) {
+							res.Diagnostics.AddAttributeError(
+								req.Path,
+								"invalid build tool",
+								fmt.Sprintf("expect one of %s, got '%s'", strings.Join(buildTools, ", "), req.ConfigValue.ValueString()),
+							)
+						}
+					}),
+				},
+			},
+			// CC_GO_PKG
+			"go_package": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Package to build and run, used by `gomod` and `gobuild` build tools (default: `main.go`)",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (g *Go) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (g *Go) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(g.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(g.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(g.BuildTool, func(s string) { env["CC_GO_BUILD_TOOL"] = s })
+	pkg.IfIsSet(g.GoPackage, func(s string) { env["CC_GO_PKG"] = s })
+	env = pkg.Merge(env, g.Hooks.ToEnv())
+
+	return env
+}
+
+func (g *Go) toDeployment() *application.Deployment {
+	if g.Deployment == nil || g.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: g.Deployment.Repository.ValueString(),
+		Commit:     g.Deployment.Commit.ValueStringPointer(),
+	}
+}
@@ -0,0 +1,22 @@
+package haskell
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceHaskell struct {
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func NewResourceHaskell() resource.Resource {
+	return &ResourceHaskell{}
+}
+
+func (r *ResourceHaskell) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_haskell"
+}
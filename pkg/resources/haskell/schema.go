@@ -0,0 +1,72 @@
+package haskell
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Haskell struct {
+	attributes.Runtime
+	RunCommand types.String `tfsdk:"run_command"`
+}
+
+//go:embed doc.md
+var haskellDoc string
+
+func (r ResourceHaskell) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: haskellDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// CC_RUN_COMMAND
+			"run_command": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Stack or cabal command used to start the application (default: the first executable of the package)",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (hs *Haskell) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (hs *Haskell) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(hs.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(hs.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(hs.RunCommand, func(s string) { env["CC_RUN_COMMAND"] = s })
+	env = pkg.Merge(env, hs.Hooks.ToEnv())
+
+	return env
+}
+
+func (hs *Haskell) toDeployment() *application.Deployment {
+	if hs.Deployment == nil || hs.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: hs.Deployment.Repository.ValueString(),
+		Commit:     hs.Deployment.Commit.ValueStringPointer(),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 	"go.clever-cloud.com/terraform-provider/pkg/provider"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
@@ -27,21 +28,63 @@ func (r *ResourceJava) Configure(ctx context.Context, req resource.ConfigureRequ
 	if ok {
 		r.cc = provider.Client()
 		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
 	}
 
 	tflog.Debug(ctx, "AFTER CONFIGURED", map[string]interface{}{"cc": r.cc == nil, "org": r.org})
 }
 
+// Check the configuration does not contradict itself
+func (r *ResourceJava) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	model := r.newModel()
+
+	res.Diagnostics.Append(req.Config.Get(ctx, model)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(model.java().Validate()...)
+}
+
+// Check the plan against the product catalog
+func (r *ResourceJava) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction or provider not configured yet
+	if req.Plan.Raw.IsNull() || r.cc == nil {
+		return
+	}
+
+	model := r.newModel()
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, model)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(application.ValidateRuntime(ctx, r.cc, "java", r.toProductName(), model.java().Runtime)...)
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+}
+
 // Create a new resource
 func (r *ResourceJava) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	plan := Java{}
+	model := r.newModel()
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan := model.java()
 
-	instance := application.LookupInstance(ctx, r.cc, "java", r.toProductName(), resp.Diagnostics)
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "java", r.toProductName(), plan.TargetInstanceVersion())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -52,11 +95,18 @@ func (r *ResourceJava) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	environment := plan.toEnv(ctx, resp.Diagnostics)
+	environment := pkg.Merge(plan.toEnv(ctx, resp.Diagnostics), model.profileEnv())
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
 	createAppReq := application.CreateReq{
 		Client:       r.cc,
 		Organization: r.org,
@@ -75,38 +125,57 @@ func (r *ResourceJava) Create(ctx context.Context, req resource.CreateRequest, r
 			StickySessions:  plan.StickySessions.ValueBool(),
 			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
 			Zone:            plan.Region.ValueString(),
-			CancelOnPush:    false,
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
 		},
 		Environment: environment,
 		VHosts:      vhosts,
 		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
 	}
 
 	createAppRes, diags := application.CreateApp(ctx, createAppReq)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// once created, the application is saved even on partial failure so it gets tainted
+	if createAppRes == nil {
 		return
 	}
 
 	tflog.Debug(ctx, "BUILD FLAVOR RES"+createAppRes.Application.BuildFlavor.Name, map[string]interface{}{})
 	plan.ID = pkg.FromStr(createAppRes.Application.ID)
+	plan.ResolvedInstanceVersion = pkg.FromStr(createAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(createAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(createAppRes.Application.WebhookSecret)
 	plan.DeployURL = pkg.FromStr(createAppRes.Application.DeployURL)
 	plan.VHost = pkg.FromStr(createAppRes.Application.Vhosts[0].Fqdn)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 	if resp.Diagnostics.HasError() {
-		return
+		// attributes left unknown by a partial failure are saved as null
+		resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
 	}
 }
 
 // Read resource information
 func (r *ResourceJava) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state Java
+	model := r.newModel()
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state := model.java()
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	readRes, diags := application.ReadApp(ctx, r.cc, r.org, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
@@ -119,6 +188,21 @@ func (r *ResourceJava) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	state.ResolvedInstanceVersion = pkg.FromStr(readRes.App.Instance.Version)
+	state.CancelOnPush = pkg.FromBool(readRes.App.CancelOnPush)
+	state.Homogeneous = pkg.FromBool(readRes.App.Homogeneous)
+	state.SeparateBuild = pkg.FromBool(readRes.App.SeparateBuild)
+	state.Running = readRes.Running(state.Running)
+	state.WebhookURL = pkg.FromStr(readRes.App.WebhookURL)
+	state.WebhookSecret = pkg.FromStr(readRes.App.WebhookSecret)
+
+	state.TagsAll = pkg.FromSetString(readRes.Tags)
+	state.Tags, diags = pkg.TagsFromRemote(ctx, readRes.Tags, state.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state.Name = pkg.FromStr(readRes.App.Name)
 	state.Description = pkg.FromStr(readRes.App.Description)
 	state.MinInstanceCount = pkg.FromI(int64(readRes.App.Instance.MinInstances))
@@ -149,10 +233,7 @@ func (r *ResourceJava) Read(ctx context.Context, req resource.ReadRequest, resp
 		state.VHost = types.StringNull()
 	}
 
-	vhostsWithoutDefault := pkg.Filter(vhosts, func(vhost string) bool {
-		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
-		return !ok
-	})
+	vhostsWithoutDefault := application.TrackedVHosts(ctx, vhosts, state.AdditionalVHosts)
 	if len(vhostsWithoutDefault) > 0 {
 		state.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
 	} else {
@@ -164,28 +245,150 @@ func (r *ResourceJava) Read(ctx context.Context, req resource.ReadRequest, resp
 		case "APP_FOLDER":
 			state.AppFolder = pkg.FromStr(envValue)
 		case "CC_JAVA_VERSION":
-			state.AppFolder = pkg.FromStr(envValue)
+			state.JavaVersion = pkg.FromStr(envValue)
 		default:
+			if model.fromEnv(envName, envValue) {
+				continue
+			}
 			//state.Environment.
 		}
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
 }
 
 // Update resource
 func (r *ResourceJava) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
-	// TODO
+	planModel := r.newModel()
+	stateModel := r.newModel()
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, planModel)...)
+	res.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan, state := planModel.java(), stateModel.java()
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "java", r.toProductName(), plan.TargetInstanceVersion())
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	environment := pkg.Merge(plan.toEnv(ctx, res.Diagnostics), planModel.profileEnv())
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	vhosts := []string{}
+	if res.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	updateAppReq := application.UpdateReq{
+		ID:           state.ID.ValueString(),
+		Client:       r.cc,
+		Organization: r.org,
+		Application: tmp.UpdateAppReq{
+			Name:            plan.Name.ValueString(),
+			Deploy:          "git",
+			Description:     plan.Description.ValueString(),
+			InstanceType:    instance.Type,
+			InstanceVariant: instance.Variant.ID,
+			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
+			MinFlavor:       plan.SmallestFlavor.ValueString(),
+			MaxFlavor:       plan.BiggestFlavor.ValueString(),
+			MinInstances:    plan.MinInstanceCount.ValueInt64(),
+			MaxInstances:    plan.MaxInstanceCount.ValueInt64(),
+			StickySessions:  plan.StickySessions.ValueBool(),
+			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
+			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
+		},
+		Environment: environment,
+		VHosts:      vhosts,
+		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
+	}
+
+	updateAppRes, diags := application.UpdateApp(ctx, updateAppReq)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.ResolvedInstanceVersion = pkg.FromStr(updateAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(updateAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(updateAppRes.Application.WebhookSecret)
+
+	hasDefaultVHost := pkg.HasSome(updateAppReq.VHosts, func(vhost string) bool {
+		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+	})
+	if hasDefaultVHost {
+		cleverapps := *pkg.First(vhosts, func(vhost string) bool {
+			return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		})
+		plan.VHost = pkg.FromStr(cleverapps)
+	} else {
+		plan.VHost = types.StringNull()
+	}
+
+	vhostsWithoutDefault := pkg.Filter(updateAppReq.VHosts, func(vhost string) bool {
+		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		return !ok
+	})
+	if len(vhostsWithoutDefault) > 0 {
+		plan.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
+	} else {
+		plan.AdditionalVHosts = types.ListNull(types.StringType)
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, planModel)...)
 }
 
 // Delete resource
 func (r *ResourceJava) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state Java
+	model := r.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := model.java()
 
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(state.DeletionProtection)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "JAVA DELETE", map[string]interface{}{"state": state})
 
 	res := tmp.DeleteApp(ctx, r.cc, r.org, state.ID.ValueString())
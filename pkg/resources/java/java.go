@@ -8,10 +8,11 @@ import (
 )
 
 type ResourceJava struct {
-	// war /
-	profile string
-	cc      *client.Client
-	org     string
+	// war / jar / maven / gradle / play
+	profile     string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceJava(profile string) func() resource.Resource {
@@ -30,9 +31,10 @@ func (r *ResourceJava) Metadata(ctx context.Context, req resource.MetadataReques
 
 // Convert a profile into product name
 func (r *ResourceJava) toProductName() string {
-	m := map[string]string{
-		"war": "Java + WAR",
-	}
+	return profiles[r.profile].productName
+}
 
-	return m[r.profile]
+// Empty model matching the profile schema
+func (r *ResourceJava) newModel() javaModel {
+	return profiles[r.profile].model()
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/helper"
 	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
@@ -78,3 +79,85 @@ func TestAccJava_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccJava_jar(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-java-jar-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_java_jar.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	javaBlock := helper.NewRessource(
+		"clevercloud_java_jar",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 2,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "M",
+			"jar_path":           "target/app.jar",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			Destroy:      false,
+			ResourceName: rName,
+			Config:       providerBlock.Append(javaBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*$`)),
+				resource.TestMatchResourceAttr(fullName, "deploy_url", regexp.MustCompile(`^git\+ssh.*\.git$`)),
+				resource.TestCheckResourceAttr(fullName, "region", "par"),
+				resource.TestCheckResourceAttr(fullName, "jar_path", "target/app.jar"),
+			),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(javaBlock.SetOneValue("jar_path", "build/libs/app.jar")).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(fullName, "jar_path", "build/libs/app.jar"),
+				func(state *terraform.State) error {
+					id := state.RootModule().Resources[fullName].Primary.ID
+
+					appEnvRes := tmp.GetAppEnv(ctx, cc, org, id)
+					if appEnvRes.HasError() {
+						return fmt.Errorf("failed to get application env: %w", appEnvRes.Error())
+					}
+
+					env := pkg.Reduce(*appEnvRes.Payload(), map[string]string{}, func(acc map[string]string, e tmp.Env) map[string]string {
+						acc[e.Name] = e.Value
+						return acc
+					})
+
+					if env["CC_JAR_PATH"] != "build/libs/app.jar" {
+						return fmt.Errorf("bad env var value CC_JAR_PATH, got: '%s', expect: 'build/libs/app.jar'", env["CC_JAR_PATH"])
+					}
+					return nil
+				},
+			),
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				res := tmp.GetApp(ctx, cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+				if res.Payload().State == "TO_DELETE" {
+					continue
+				}
+
+				return fmt.Errorf("expect resource '%s' to be deleted state: '%s'", resource.Primary.ID, res.Payload().State)
+			}
+			return nil
+		},
+	})
+}
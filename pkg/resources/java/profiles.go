@@ -0,0 +1,172 @@
+package java
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+)
+
+// Implemented by each profile model, which embeds Java
+type javaModel interface {
+	java() *Java
+	// environment variables specific to the profile
+	profileEnv() map[string]string
+	// refresh a profile attribute, return false if the variable is unknown
+	fromEnv(name, value string) bool
+}
+
+type profile struct {
+	productName string
+	attributes  map[string]schema.Attribute
+	model       func() javaModel
+}
+
+var profiles = map[string]profile{
+	"war": {
+		productName: "Java + WAR",
+		attributes:  map[string]schema.Attribute{},
+		model:       func() javaModel { return &JavaWar{} },
+	},
+	"jar": {
+		productName: "Java + JAR",
+		attributes: map[string]schema.Attribute{
+			"jar_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to the JAR file to run, relative to the application root (`CC_JAR_PATH`)",
+			},
+			"jar_args": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arguments given to the JAR on startup (`CC_JAR_ARGS`)",
+			},
+		},
+		model: func() javaModel { return &JavaJar{} },
+	},
+	"maven": {
+		productName: "Java + Maven",
+		attributes: map[string]schema.Attribute{
+			"maven_profiles": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comma separated list of Maven profiles to enable during build (`CC_MAVEN_PROFILES`)",
+			},
+		},
+		model: func() javaModel { return &JavaMaven{} },
+	},
+	"gradle": {
+		productName: "Java + Gradle",
+		attributes: map[string]schema.Attribute{
+			"gradle_task": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Gradle task used to build and run the application (`GRADLE_DEPLOY_GOAL`)",
+			},
+		},
+		model: func() javaModel { return &JavaGradle{} },
+	},
+	"play": {
+		productName: "Java + Play! 2",
+		attributes: map[string]schema.Attribute{
+			"sbt_target": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Folder where SBT outputs the binary to run (`CC_SBT_TARGET_DIR`)",
+			},
+		},
+		model: func() javaModel { return &JavaPlay{} },
+	},
+}
+
+type JavaWar struct {
+	Java
+}
+
+func (j *JavaWar) java() *Java                     { return &j.Java }
+func (j *JavaWar) profileEnv() map[string]string   { return map[string]string{} }
+func (j *JavaWar) fromEnv(name, value string) bool { return false }
+
+type JavaJar struct {
+	Java
+	JarPath types.String `tfsdk:"jar_path"`
+	JarArgs types.String `tfsdk:"jar_args"`
+}
+
+func (j *JavaJar) java() *Java { return &j.Java }
+
+func (j *JavaJar) profileEnv() map[string]string {
+	env := map[string]string{}
+	pkg.IfIsSet(j.JarPath, func(s string) { env["CC_JAR_PATH"] = s })
+	pkg.IfIsSet(j.JarArgs, func(s string) { env["CC_JAR_ARGS"] = s })
+	return env
+}
+
+func (j *JavaJar) fromEnv(name, value string) bool {
+	switch name {
+	case "CC_JAR_PATH":
+		j.JarPath = pkg.FromStr(value)
+	case "CC_JAR_ARGS":
+		j.JarArgs = pkg.FromStr(value)
+	default:
+		return false
+	}
+	return true
+}
+
+type JavaMaven struct {
+	Java
+	MavenProfiles types.String `tfsdk:"maven_profiles"`
+}
+
+func (j *JavaMaven) java() *Java { return &j.Java }
+
+func (j *JavaMaven) profileEnv() map[string]string {
+	env := map[string]string{}
+	pkg.IfIsSet(j.MavenProfiles, func(s string) { env["CC_MAVEN_PROFILES"] = s })
+	return env
+}
+
+func (j *JavaMaven) fromEnv(name, value string) bool {
+	if name != "CC_MAVEN_PROFILES" {
+		return false
+	}
+	j.MavenProfiles = pkg.FromStr(value)
+	return true
+}
+
+type JavaGradle struct {
+	Java
+	GradleTask types.String `tfsdk:"gradle_task"`
+}
+
+func (j *JavaGradle) java() *Java { return &j.Java }
+
+func (j *JavaGradle) profileEnv() map[string]string {
+	env := map[string]string{}
+	pkg.IfIsSet(j.GradleTask, func(s string) { env["GRADLE_DEPLOY_GOAL"] = s })
+	return env
+}
+
+func (j *JavaGradle) fromEnv(name, value string) bool {
+	if name != "GRADLE_DEPLOY_GOAL" {
+		return false
+	}
+	j.GradleTask = pkg.FromStr(value)
+	return true
+}
+
+type JavaPlay struct {
+	Java
+	SbtTarget types.String `tfsdk:"sbt_target"`
+}
+
+func (j *JavaPlay) java() *Java { return &j.Java }
+
+func (j *JavaPlay) profileEnv() map[string]string {
+	env := map[string]string{}
+	pkg.IfIsSet(j.SbtTarget, func(s string) { env["CC_SBT_TARGET_DIR"] = s })
+	return env
+}
+
+func (j *JavaPlay) fromEnv(name, value string) bool {
+	if name != "CC_SBT_TARGET_DIR" {
+		return false
+	}
+	j.SbtTarget = pkg.FromStr(value)
+	return true
+}
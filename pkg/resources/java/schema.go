@@ -25,12 +25,12 @@ func (r ResourceJava) Schema(ctx context.Context, req resource.SchemaRequest, re
 	res.Schema = schema.Schema{
 		Version:             0,
 		MarkdownDescription: javaDoc,
-		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+		Attributes: attributes.WithRuntimeCommons(pkg.Merge(map[string]schema.Attribute{
 			"java_version": schema.StringAttribute{
 				Optional:    true,
 				Description: "Choose the JVM version between 7 to 17 for OpenJDK or graalvm-ce for GraalVM 21.0.0.2 (based on OpenJDK 11.0).",
 			},
-		}),
+		}, profiles[r.profile].attributes)),
 		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
 	}
 }
@@ -1,21 +1,45 @@
 package keycloak
 
 import (
-	"context"
-
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"go.clever-cloud.dev/client"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
 
 type ResourceKeycloak struct {
-	cc  *client.Client
-	org string
+	addon.Resource[Keycloak, *Keycloak, []tmp.EnvVar]
 }
 
 func NewResourceKeycloak() resource.Resource {
-	return &ResourceKeycloak{}
+	return &ResourceKeycloak{addon.Resource[Keycloak, *Keycloak, []tmp.EnvVar]{
+		Engine:      engine,
+		TypeName:    "keycloak",
+		CheckActive: checkActive,
+	}}
+}
+
+// Keycloak has no status, its URL is exposed by the addon env
+var engine = addon.Engine[*Keycloak, []tmp.EnvVar]{
+	ProviderID:  "keycloak",
+	DefaultPlan: "beta",
+	UseRealID:   true,
+	GetDetail:   tmp.GetAddonEnv,
+	MapDetail: func(kc *Keycloak, env *[]tmp.EnvVar) {
+		hostEnvVar := pkg.First(*env, func(v tmp.EnvVar) bool {
+			return v.Name == "CC_KEYCLOAK_URL"
+		})
+		if hostEnvVar != nil {
+			kc.Host = pkg.FromStr(hostEnvVar.Value)
+		}
+	},
 }
 
-func (r *ResourceKeycloak) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_keycloak"
+func checkActive(kc *Keycloak) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if kc.Host.IsNull() || kc.Host.IsUnknown() {
+		diags.AddError("cannot get Keycloak infos", "missing CC_KEYCLOAK_URL env var on created addon")
+	}
+	return diags
 }
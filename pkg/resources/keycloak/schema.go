@@ -4,10 +4,14 @@ import (
 	"context"
 	_ "embed"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
 type Keycloak struct {
@@ -15,7 +19,41 @@ type Keycloak struct {
 	Name         types.String `tfsdk:"name"`
 	CreationDate types.Int64  `tfsdk:"creation_date"`
 	Region       types.String `tfsdk:"region"`
+	Tags         types.Set    `tfsdk:"tags"`
+	TagsAll      types.Set    `tfsdk:"tags_all"`
 	Host         types.String `tfsdk:"host"`
+
+	AdoptExisting      types.Bool `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ToAddon and FromAddon let the addon engine work on Keycloak, which has no plan
+func (kc Keycloak) ToAddon() attributes.Addon {
+	return attributes.Addon{
+		ID:                 kc.ID,
+		Name:               kc.Name,
+		Region:             kc.Region,
+		Tags:               kc.Tags,
+		TagsAll:            kc.TagsAll,
+		CreationDate:       kc.CreationDate,
+		AdoptExisting:      kc.AdoptExisting,
+		DeletionProtection: kc.DeletionProtection,
+		Timeouts:           kc.Timeouts,
+	}
+}
+
+func (kc *Keycloak) FromAddon(a attributes.Addon) {
+	kc.ID = a.ID
+	kc.Name = a.Name
+	kc.Region = a.Region
+	kc.Tags = a.Tags
+	kc.TagsAll = a.TagsAll
+	kc.CreationDate = a.CreationDate
+	kc.AdoptExisting = a.AdoptExisting
+	kc.DeletionProtection = a.DeletionProtection
+	kc.Timeouts = a.Timeouts
 }
 
 //go:embed doc.md
@@ -26,17 +64,23 @@ func (r ResourceKeycloak) Schema(_ context.Context, req resource.SchemaRequest,
 		Version:             0,
 		MarkdownDescription: resourceKeycloakDoc,
 		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service"},
+			"name":                schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service"},
+			"adopt_existing":      attributes.AdoptExistingAttribute,
+			"deletion_protection": attributes.DeletionProtectionAttribute,
+			"tags":                attributes.AddonTagsAttribute,
+			"tags_all":            attributes.AddonTagsAllAttribute,
 			"region": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("par"),
 				MarkdownDescription: "Geographical region where the data will be stored",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"id":            schema.StringAttribute{Computed: true, MarkdownDescription: "Generated unique identifier"},
 			"creation_date": schema.Int64Attribute{Computed: true, MarkdownDescription: "Date of database creation"},
 			"host":          schema.StringAttribute{Computed: true, MarkdownDescription: "URL to access Keycloak"},
 		},
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
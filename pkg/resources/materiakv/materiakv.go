@@ -1,21 +1,32 @@
 package materiakv
 
 import (
-	"context"
-
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"go.clever-cloud.dev/client"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
 
 type ResourceMateriaKV struct {
-	cc  *client.Client
-	org string
+	addon.Resource[MateriaKV, *MateriaKV, tmp.MateriaKV]
 }
 
 func NewResourceMateriaKV() resource.Resource {
-	return &ResourceMateriaKV{}
+	return &ResourceMateriaKV{addon.Resource[MateriaKV, *MateriaKV, tmp.MateriaKV]{
+		Engine:   engine,
+		TypeName: "materia_kv",
+	}}
 }
 
-func (r *ResourceMateriaKV) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_materia_kv"
+var engine = addon.Engine[*MateriaKV, tmp.MateriaKV]{
+	ProviderID:  "kv",
+	DefaultPlan: "alpha",
+	UseRealID:   true,
+	GetDetail:   tmp.GetMateriaKV,
+	Status:      func(detail *tmp.MateriaKV) string { return detail.Status },
+	MapDetail: func(kv *MateriaKV, detail *tmp.MateriaKV) {
+		kv.Host = pkg.FromStr(detail.Host)
+		kv.Port = pkg.FromI(detail.Port)
+		kv.Token = pkg.FromStr(detail.Token)
+	},
 }
@@ -4,10 +4,14 @@ import (
 	"context"
 	_ "embed"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
 type MateriaKV struct {
@@ -17,7 +21,41 @@ type MateriaKV struct {
 	Host         types.String `tfsdk:"host"`
 	Port         types.Int64  `tfsdk:"port"`
 	Region       types.String `tfsdk:"region"`
+	Tags         types.Set    `tfsdk:"tags"`
+	TagsAll      types.Set    `tfsdk:"tags_all"`
 	Token        types.String `tfsdk:"token"`
+
+	AdoptExisting      types.Bool `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ToAddon and FromAddon let the addon engine work on MateriaKV, which has no plan
+func (kv MateriaKV) ToAddon() attributes.Addon {
+	return attributes.Addon{
+		ID:                 kv.ID,
+		Name:               kv.Name,
+		Region:             kv.Region,
+		Tags:               kv.Tags,
+		TagsAll:            kv.TagsAll,
+		CreationDate:       kv.CreationDate,
+		AdoptExisting:      kv.AdoptExisting,
+		DeletionProtection: kv.DeletionProtection,
+		Timeouts:           kv.Timeouts,
+	}
+}
+
+func (kv *MateriaKV) FromAddon(a attributes.Addon) {
+	kv.ID = a.ID
+	kv.Name = a.Name
+	kv.Region = a.Region
+	kv.Tags = a.Tags
+	kv.TagsAll = a.TagsAll
+	kv.CreationDate = a.CreationDate
+	kv.AdoptExisting = a.AdoptExisting
+	kv.DeletionProtection = a.DeletionProtection
+	kv.Timeouts = a.Timeouts
 }
 
 //go:embed doc.md
@@ -29,12 +67,17 @@ func (r ResourceMateriaKV) Schema(_ context.Context, req resource.SchemaRequest,
 		MarkdownDescription: resourceMateriaKVDoc,
 		Attributes: map[string]schema.Attribute{
 			// customer provided
-			"name": schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service"},
+			"name":                schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service"},
+			"adopt_existing":      attributes.AdoptExistingAttribute,
+			"deletion_protection": attributes.DeletionProtectionAttribute,
+			"tags":                attributes.AddonTagsAttribute,
+			"tags_all":            attributes.AddonTagsAllAttribute,
 			"region": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("par"),
 				MarkdownDescription: "Geographical region where the data will be stored",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			// provider
 			"id":            schema.StringAttribute{Computed: true, MarkdownDescription: "Generated unique identifier"},
@@ -43,6 +86,7 @@ func (r ResourceMateriaKV) Schema(_ context.Context, req resource.SchemaRequest,
 			"port":          schema.Int64Attribute{Computed: true, MarkdownDescription: "Database port"},
 			"token":         schema.StringAttribute{Computed: true, MarkdownDescription: "Token to authenticate"},
 		},
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
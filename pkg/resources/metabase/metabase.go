@@ -3,19 +3,53 @@ package metabase
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
 
 type ResourceMetabase struct {
-	cc  *client.Client
-	org string
+	addon.Resource[Metabase, *Metabase, tmp.Metabase]
 }
 
 func NewResourceMetabase() resource.Resource {
-	return &ResourceMetabase{}
+	return &ResourceMetabase{addon.Resource[Metabase, *Metabase, tmp.Metabase]{
+		Engine:   engine,
+		TypeName: "metabase",
+	}}
 }
 
-func (r *ResourceMetabase) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_metabase"
+var engine = addon.Engine[*Metabase, tmp.Metabase]{
+	ProviderID: "metabase",
+	GetDetail: func(ctx context.Context, cc *client.Client, _, addonID string) client.Response[tmp.Metabase] {
+		return tmp.GetMetabase(ctx, cc, addonID)
+	},
+	Status:    func(detail *tmp.Metabase) string { return detail.Status },
+	MapDetail: func(mb *Metabase, detail *tmp.Metabase) {},
+	Refresh:   readHost,
+}
+
+// the host is only exposed by the addon env
+func readHost(ctx context.Context, cc *client.Client, organisation string, mb *Metabase) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	envRes := tmp.GetAddonEnv(ctx, cc, organisation, mb.ID.ValueString())
+	if envRes.HasError() {
+		diags.AddError("failed to get Metabase connection infos", envRes.Error().Error())
+		return diags
+	}
+
+	hostEnvVar := pkg.First(*envRes.Payload(), func(v tmp.EnvVar) bool {
+		return v.Name == "METABASE_URL"
+	})
+	if hostEnvVar == nil {
+		diags.AddError("cannot get Metabase infos", "missing METABASE_URL env var on addon")
+		return diags
+	}
+
+	mb.Host = pkg.FromStr(hostEnvVar.Value)
+	return diags
 }
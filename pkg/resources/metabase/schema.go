@@ -35,6 +35,7 @@ func (r ResourceMetabase) Schema(_ context.Context, req resource.SchemaRequest,
 			// "logplex_token": schema.StringAttribute{Computed: true, MarkdownDescription: "logplex_token"},
 			// "owner_id":      schema.StringAttribute{Computed: true, MarkdownDescription: "owner_id"},
 		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
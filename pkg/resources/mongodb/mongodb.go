@@ -4,18 +4,37 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
 
 type ResourceMongoDB struct {
-	cc  *client.Client
-	org string
+	addon.Resource[MongoDB, *MongoDB, tmp.MongoDB]
 }
 
 func NewResourceMongoDB() resource.Resource {
-	return &ResourceMongoDB{}
+	return &ResourceMongoDB{addon.Resource[MongoDB, *MongoDB, tmp.MongoDB]{
+		Engine:   engine,
+		TypeName: "mongodb",
+		Options:  (*MongoDB).options,
+	}}
 }
 
-func (r *ResourceMongoDB) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_mongodb"
+var engine = addon.Engine[*MongoDB, tmp.MongoDB]{
+	ProviderID: "mongodb-addon",
+	GetDetail: func(ctx context.Context, cc *client.Client, _, addonID string) client.Response[tmp.MongoDB] {
+		return tmp.GetMongoDB(ctx, cc, addonID)
+	},
+	Status: func(detail *tmp.MongoDB) string { return detail.Status },
+	MapDetail: func(mg *MongoDB, detail *tmp.MongoDB) {
+		mg.Host = pkg.FromStr(detail.Host)
+		mg.Port = pkg.FromI(detail.Port)
+		mg.User = pkg.FromStr(detail.User)
+		mg.Password = pkg.FromStr(detail.Password)
+		mg.Version = addon.Version(mg.Version, detail.Version)
+		mg.Encryption = pkg.FromBool(addon.FeatureEnabled(detail.Features, addon.OptionEncryption))
+		mg.DirectHostOnly = pkg.FromBool(addon.FeatureEnabled(detail.Features, addon.OptionDirectHostOnly))
+	},
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
 	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
@@ -16,6 +18,11 @@ type MongoDB struct {
 	Port     types.Int64  `tfsdk:"port"`
 	User     types.String `tfsdk:"user"`
 	Password types.String `tfsdk:"password"`
+
+	// Options
+	Version        types.String `tfsdk:"version"`
+	Encryption     types.Bool   `tfsdk:"encryption"`
+	DirectHostOnly types.Bool   `tfsdk:"direct_host_only"`
 }
 
 //go:embed doc.md
@@ -26,15 +33,36 @@ func (r ResourceMongoDB) Schema(_ context.Context, req resource.SchemaRequest, r
 		Version:             0,
 		MarkdownDescription: resourceMongoDBDoc,
 		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
+			"version":          attributes.VersionAttribute,
+			"encryption":       attributes.EncryptionAttribute,
+			"direct_host_only": attributes.DirectHostOnlyAttribute,
 			// customer provided
 			"host":     schema.StringAttribute{Computed: true, MarkdownDescription: "Database host, used to connect to"},
 			"port":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Database port"},
 			"user":     schema.StringAttribute{Computed: true, MarkdownDescription: "Login username"},
 			"password": schema.StringAttribute{Computed: true, MarkdownDescription: "Login password"},
 		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
+func (mg MongoDB) options() map[string]string {
+	options := map[string]string{}
+
+	pkg.IfIsSet(mg.Version, func(version string) {
+		options[addon.OptionVersion] = version
+	})
+	// features are only sent when enabled, not every version supports them
+	if mg.Encryption.ValueBool() {
+		options[addon.OptionEncryption] = "true"
+	}
+	if mg.DirectHostOnly.ValueBool() {
+		options[addon.OptionDirectHostOnly] = "true"
+	}
+
+	return options
+}
+
 // https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
 func (r ResourceMongoDB) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{}
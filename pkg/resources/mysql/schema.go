@@ -0,0 +1,61 @@
+package mysql
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type MySQL struct {
+	attributes.Addon
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Database types.String `tfsdk:"database"`
+	User     types.String `tfsdk:"user"`
+	Password types.String `tfsdk:"password"`
+	URI      types.String `tfsdk:"uri"`
+
+	// Options
+	Version types.String `tfsdk:"version"`
+}
+
+//go:embed doc.md
+var resourceMysqlDoc string
+
+func (r ResourceMySQL) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceMysqlDoc,
+		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
+			"version":  attributes.VersionAttribute,
+			"host":     schema.StringAttribute{Computed: true, MarkdownDescription: "Database host, used to connect to"},
+			"port":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Database port"},
+			"database": schema.StringAttribute{Computed: true, MarkdownDescription: "Database name on the MySQL server"},
+			"user":     schema.StringAttribute{Computed: true, MarkdownDescription: "Login username"},
+			"password": schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Login password"},
+			"uri":      schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Connection URI, including credentials"},
+		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
+	}
+}
+
+func (my MySQL) options() map[string]string {
+	options := map[string]string{}
+
+	pkg.IfIsSet(my.Version, func(version string) {
+		options[addon.OptionVersion] = version
+	})
+
+	return options
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceMySQL) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
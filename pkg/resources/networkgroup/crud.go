@@ -0,0 +1,183 @@
+package networkgroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceNetworkgroup) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceNetworkgroup.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+// Create a new resource
+func (r *ResourceNetworkgroup) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := Networkgroup{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// the identifier is chosen by the client
+	ngID := "ng_" + uuid.NewString()
+
+	res := tmp.CreateNetworkgroup(ctx, r.cc, r.org, tmp.NetworkgroupRequest{
+		ID:          ngID,
+		OwnerID:     r.org,
+		Label:       plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Tags:        []string{},
+	})
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to create Network Group", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(ngID)
+
+	// creation is asynchronous
+	var ng *tmp.Networkgroup
+	err := pkg.WaitFor(ctx, 2*time.Second, func() (bool, error) {
+		ngRes := tmp.GetNetworkgroup(ctx, r.cc, r.org, ngID)
+		if ngRes.IsNotFoundError() {
+			return false, nil
+		}
+		if ngRes.HasError() {
+			return false, ngRes.Error()
+		}
+
+		ng = ngRes.Payload()
+		return true, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get Network Group", err.Error())
+		// keep track of the Network Group anyway, so it is tainted
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ngID)...)
+		return
+	}
+
+	plan.NetworkIP = pkg.FromStr(ng.NetworkIP)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read resource information
+func (r *ResourceNetworkgroup) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Networkgroup READ", map[string]interface{}{"request": req})
+
+	var state Networkgroup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res := tmp.GetNetworkgroup(ctx, r.cc, r.org, state.ID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to get Network Group", res.Error().Error())
+		return
+	}
+
+	ng := res.Payload()
+	state.Name = pkg.FromStr(ng.Label)
+	if ng.Description != "" {
+		state.Description = pkg.FromStr(ng.Description)
+	}
+	state.NetworkIP = pkg.FromStr(ng.NetworkIP)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceNetworkgroup) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := Networkgroup{}
+	state := Networkgroup{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// name and description both require a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourceNetworkgroup) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Networkgroup
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "NETWORKGROUP DELETE", map[string]interface{}{"networkgroup": state.ID.ValueString()})
+
+	res := tmp.DeleteNetworkgroup(ctx, r.cc, r.org, state.ID.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete Network Group", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourceNetworkgroup) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,174 @@
+package member
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceNetworkgroupMember) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceNetworkgroupMember.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+// Create a new resource
+func (r *ResourceNetworkgroupMember) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := Member{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ngID := plan.NetworkgroupID.ValueString()
+	memberID := plan.MemberID.ValueString()
+
+	label := memberID
+	if !plan.Label.IsUnknown() && !plan.Label.IsNull() {
+		label = plan.Label.ValueString()
+	}
+
+	member := tmp.NetworkgroupMember{
+		ID:         memberID,
+		Label:      label,
+		DomainName: fmt.Sprintf("%s.m.%s.cc-ng.cloud", memberID, ngID),
+		Kind:       plan.Kind.ValueString(),
+	}
+
+	res := tmp.AddNetworkgroupMember(ctx, r.cc, r.org, ngID, member)
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to add Network Group member", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(ngID + "/" + memberID)
+	plan.Label = pkg.FromStr(member.Label)
+	plan.DomainName = pkg.FromStr(member.DomainName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read resource information
+func (r *ResourceNetworkgroupMember) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "NetworkgroupMember READ", map[string]interface{}{"request": req})
+
+	var state Member
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res := tmp.GetNetworkgroupMember(ctx, r.cc, r.org, state.NetworkgroupID.ValueString(), state.MemberID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to get Network Group member", res.Error().Error())
+		return
+	}
+
+	member := res.Payload()
+	state.Kind = pkg.FromStr(member.Kind)
+	state.Label = pkg.FromStr(member.Label)
+	state.DomainName = pkg.FromStr(member.DomainName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceNetworkgroupMember) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := Member{}
+	state := Member{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every attribute requires a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourceNetworkgroupMember) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Member
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "NETWORKGROUP MEMBER DELETE", map[string]interface{}{"member": state.ID.ValueString()})
+
+	res := tmp.DeleteNetworkgroupMember(ctx, r.cc, r.org, state.NetworkgroupID.ValueString(), state.MemberID.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete Network Group member", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourceNetworkgroupMember) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ngID, memberID, ok := strings.Cut(req.ID, "/")
+	if !ok || ngID == "" || memberID == "" {
+		resp.Diagnostics.AddError("invalid import identifier", fmt.Sprintf("expect 'networkgroup_id/member_id', got '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("networkgroup_id"), ngID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), memberID)...)
+}
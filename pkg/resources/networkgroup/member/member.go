@@ -0,0 +1,21 @@
+package member
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceNetworkgroupMember struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceNetworkgroupMember() resource.Resource {
+	return &ResourceNetworkgroupMember{}
+}
+
+func (r *ResourceNetworkgroupMember) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_networkgroup_member"
+}
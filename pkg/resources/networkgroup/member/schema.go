@@ -0,0 +1,104 @@
+package member
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+type Member struct {
+	ID             types.String `tfsdk:"id"`
+	NetworkgroupID types.String `tfsdk:"networkgroup_id"`
+	MemberID       types.String `tfsdk:"member_id"`
+	Kind           types.String `tfsdk:"kind"`
+	Label          types.String `tfsdk:"label"`
+	DomainName     types.String `tfsdk:"domain_name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var memberKinds = []string{tmp.NetworkgroupMemberApplication, tmp.NetworkgroupMemberAddon, tmp.NetworkgroupMemberExternal}
+
+//go:embed doc.md
+var resourceNetworkgroupMemberDoc string
+
+func (r ResourceNetworkgroupMember) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceNetworkgroupMemberDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"networkgroup_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Network Group to join",
+				PlanModifiers:       requiresReplace,
+			},
+			"member_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Application or addon ID, or a free name for an external member",
+				PlanModifiers:       requiresReplace,
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Member kind: `APPLICATION`, `ADDON` or `EXTERNAL`",
+				PlanModifiers:       requiresReplace,
+				Validators: []validator.String{
+					pkg.NewValidator("kind must be APPLICATION, ADDON or EXTERNAL", func(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+						if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+							return
+						}
+
+						if !slices.Contains(memberKinds, req.ConfigValue.ValueString()) {
+							res.Diagnostics.AddAttributeError(
+								req.Path,
+								"invalid member kind",
+								fmt.Sprintf("expect one of %s, got '%s'", strings.Join(memberKinds, ", "), req.ConfigValue.ValueString()),
+							)
+						}
+					}),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Member label (default: `member_id`)",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace(), stringplanmodifier.UseStateForUnknown()},
+			},
+
+			// provider provided
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier in the form `networkgroup_id/member_id`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"domain_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private domain name of the member inside the Network Group",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceNetworkgroupMember) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
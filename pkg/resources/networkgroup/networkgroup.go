@@ -0,0 +1,21 @@
+package networkgroup
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceNetworkgroup struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceNetworkgroup() resource.Resource {
+	return &ResourceNetworkgroup{}
+}
+
+func (r *ResourceNetworkgroup) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_networkgroup"
+}
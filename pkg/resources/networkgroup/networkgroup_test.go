@@ -0,0 +1,119 @@
+package networkgroup_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccNetworkgroup_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-ng-%d", time.Now().UnixMilli())
+	ngName := fmt.Sprintf("clevercloud_networkgroup.%s", rName)
+	appMemberName := fmt.Sprintf("clevercloud_networkgroup_member.%s-app", rName)
+	peerName := fmt.Sprintf("clevercloud_networkgroup_external_peer.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	ngBlock := helper.NewRessource(
+		"clevercloud_networkgroup",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":        rName,
+			"description": "terraform acceptance test",
+		}))
+	phpBlock := helper.NewRessource(
+		"clevercloud_php",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 1,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "XS",
+		}))
+	appMemberBlock := helper.NewRessource(
+		"clevercloud_networkgroup_member",
+		rName+"-app",
+		helper.SetKeyValues(map[string]any{
+			"networkgroup_id": fmt.Sprintf("${clevercloud_networkgroup.%s.id}", rName),
+			"member_id":       fmt.Sprintf("${clevercloud_php.%s.id}", rName),
+			"kind":            "APPLICATION",
+		}))
+	externalMemberBlock := helper.NewRessource(
+		"clevercloud_networkgroup_member",
+		rName+"-onprem",
+		helper.SetKeyValues(map[string]any{
+			"networkgroup_id": fmt.Sprintf("${clevercloud_networkgroup.%s.id}", rName),
+			"member_id":       "onprem",
+			"kind":            "EXTERNAL",
+		}))
+	peerBlock := helper.NewRessource(
+		"clevercloud_networkgroup_external_peer",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"networkgroup_id": fmt.Sprintf("${clevercloud_networkgroup.%s.id}", rName),
+			"member_id":       fmt.Sprintf("${clevercloud_networkgroup_member.%s-onprem.member_id}", rName),
+			"label":           "host-1",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(ngBlock, phpBlock, appMemberBlock, externalMemberBlock, peerBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(ngName, "id", regexp.MustCompile(`^ng_.*$`)),
+				resource.TestCheckResourceAttrSet(ngName, "network_ip"),
+				resource.TestMatchResourceAttr(appMemberName, "domain_name", regexp.MustCompile(`^app_.*\.cc-ng\.cloud$`)),
+				resource.TestCheckResourceAttrSet(peerName, "public_key"),
+				resource.TestCheckResourceAttrSet(peerName, "endpoint"),
+				resource.TestMatchResourceAttr(peerName, "wireguard_config", regexp.MustCompile(`PrivateKey = `)),
+			),
+		}, {
+			ResourceName:      ngName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				if resource.Type != "clevercloud_networkgroup" {
+					continue
+				}
+
+				res := tmp.GetNetworkgroup(ctx, cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				return fmt.Errorf("expect Network Group '%s' to be deleted", resource.Primary.ID)
+			}
+			return nil
+		},
+	})
+}
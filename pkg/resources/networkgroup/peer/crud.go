@@ -0,0 +1,211 @@
+package peer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceNetworkgroupExternalPeer) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceNetworkgroupExternalPeer.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+// Create a new resource
+func (r *ResourceNetworkgroupExternalPeer) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := ExternalPeer{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	privateKey, publicKey, err := generateKeyPair()
+	if err != nil {
+		resp.Diagnostics.AddError("failed to generate WireGuard keys", err.Error())
+		return
+	}
+
+	res := tmp.AddNetworkgroupExternalPeer(ctx, r.cc, r.org, plan.NetworkgroupID.ValueString(), tmp.NetworkgroupExternalPeerRequest{
+		PeerRole:     "CLIENT",
+		PublicKey:    publicKey,
+		Label:        plan.Label.ValueString(),
+		ParentMember: plan.MemberID.ValueString(),
+	})
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to add Network Group external peer", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(res.Payload().PeerID)
+	plan.PublicKey = pkg.FromStr(publicKey)
+	plan.PrivateKey = pkg.FromStr(privateKey)
+
+	// the configuration is available once the peer is provisioned
+	resp.Diagnostics.Append(r.refreshConfig(ctx, &plan, true)...)
+	if resp.Diagnostics.HasError() {
+		// only keep what is needed to delete the peer, Terraform taints it
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("networkgroup_id"), plan.NetworkgroupID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public_key"), plan.PublicKey)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("private_key"), plan.PrivateKey)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read resource information
+func (r *ResourceNetworkgroupExternalPeer) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "NetworkgroupExternalPeer READ", map[string]interface{}{"request": req})
+
+	var state ExternalPeer
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	res := tmp.GetNetworkgroupPeer(ctx, r.cc, r.org, state.NetworkgroupID.ValueString(), state.ID.ValueString())
+	if res.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to get Network Group peer", res.Error().Error())
+		return
+	}
+
+	state.Label = pkg.FromStr(res.Payload().Label)
+	state.PublicKey = pkg.FromStr(res.Payload().PublicKey)
+
+	resp.Diagnostics.Append(r.refreshConfig(ctx, &state, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceNetworkgroupExternalPeer) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := ExternalPeer{}
+	state := ExternalPeer{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every attribute requires a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourceNetworkgroupExternalPeer) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ExternalPeer
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "NETWORKGROUP PEER DELETE", map[string]interface{}{"peer": state.ID.ValueString()})
+
+	res := tmp.DeleteNetworkgroupExternalPeer(ctx, r.cc, r.org, state.NetworkgroupID.ValueString(), state.ID.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete Network Group external peer", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// refreshConfig fetches the WireGuard configuration of the peer
+// wait for it to be available when the peer was just created
+func (r *ResourceNetworkgroupExternalPeer) refreshConfig(ctx context.Context, peer *ExternalPeer, wait bool) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	var conf *tmp.NetworkgroupWireguardConfiguration
+	err := pkg.WaitFor(ctx, 2*time.Second, func() (bool, error) {
+		res := tmp.GetNetworkgroupPeerWireguardConfiguration(ctx, r.cc, r.org, peer.NetworkgroupID.ValueString(), peer.ID.ValueString())
+		if res.IsNotFoundError() && wait {
+			return false, nil
+		}
+		if res.HasError() {
+			return false, res.Error()
+		}
+
+		conf = res.Payload()
+		return true, nil
+	})
+	if err != nil {
+		diags.AddError("failed to get WireGuard configuration", err.Error())
+		return diags
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(conf.Configuration)
+	if err != nil {
+		diags.AddError("failed to decode WireGuard configuration", fmt.Sprintf("invalid base64: %s", err.Error()))
+		return diags
+	}
+
+	config := parseConfig(string(raw), peer.PrivateKey.ValueString())
+	peer.Address = pkg.FromStr(config.address)
+	peer.AllowedIPs = pkg.FromListString(config.allowedIPs)
+	peer.Endpoint = pkg.FromStr(config.endpoint)
+	peer.WireguardConfig = pkg.FromStr(config.content)
+
+	return diags
+}
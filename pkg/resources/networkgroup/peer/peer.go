@@ -0,0 +1,21 @@
+package peer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceNetworkgroupExternalPeer struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceNetworkgroupExternalPeer() resource.Resource {
+	return &ResourceNetworkgroupExternalPeer{}
+}
+
+func (r *ResourceNetworkgroupExternalPeer) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_networkgroup_external_peer"
+}
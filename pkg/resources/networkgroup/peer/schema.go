@@ -0,0 +1,105 @@
+package peer
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type ExternalPeer struct {
+	ID             types.String `tfsdk:"id"`
+	NetworkgroupID types.String `tfsdk:"networkgroup_id"`
+	MemberID       types.String `tfsdk:"member_id"`
+	Label          types.String `tfsdk:"label"`
+
+	PublicKey       types.String `tfsdk:"public_key"`
+	PrivateKey      types.String `tfsdk:"private_key"`
+	Address         types.String `tfsdk:"address"`
+	AllowedIPs      types.List   `tfsdk:"allowed_ips"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	WireguardConfig types.String `tfsdk:"wireguard_config"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+//go:embed doc.md
+var resourceNetworkgroupExternalPeerDoc string
+
+func (r ResourceNetworkgroupExternalPeer) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	useState := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceNetworkgroupExternalPeerDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"networkgroup_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Network Group to join",
+				PlanModifiers:       requiresReplace,
+			},
+			"member_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "External member the peer belongs to",
+				PlanModifiers:       requiresReplace,
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Peer label, usually the host name",
+				PlanModifiers:       requiresReplace,
+			},
+
+			// provider provided
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Peer identifier",
+				PlanModifiers:       useState,
+			},
+			"public_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "WireGuard public key of the peer",
+				PlanModifiers:       useState,
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "WireGuard private key of the peer, generated locally",
+				PlanModifiers:       useState,
+			},
+			"address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Private address of the peer",
+			},
+			"allowed_ips": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Network ranges routed through the tunnel",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "WireGuard endpoint to connect to",
+			},
+			"wireguard_config": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Complete `wg-quick` configuration, private key included",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceNetworkgroupExternalPeer) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
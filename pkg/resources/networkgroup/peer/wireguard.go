@@ -0,0 +1,76 @@
+package peer
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"strings"
+)
+
+// generateKeyPair returns a base64 encoded WireGuard (X25519) private and public key
+func generateKeyPair() (string, string, error) {
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.Bytes()),
+		base64.StdEncoding.EncodeToString(key.PublicKey().Bytes()),
+		nil
+}
+
+type wireguardConfig struct {
+	address    string
+	allowedIPs []string
+	endpoint   string
+	content    string
+}
+
+// parseConfig sets the private key in the [Interface] section
+// and extracts the values exposed as attributes
+func parseConfig(raw, privateKey string) wireguardConfig {
+	config := wireguardConfig{allowedIPs: []string{}}
+	lines := []string{}
+	section := ""
+
+	for _, line := range strings.Split(raw, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			section = trimmed
+			lines = append(lines, line)
+			if section == "[Interface]" {
+				lines = append(lines, "PrivateKey = "+privateKey)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(trimmed, "=")
+		if !ok {
+			lines = append(lines, line)
+			continue
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+
+		switch {
+		case section == "[Interface]" && key == "PrivateKey":
+			// replaced above
+			continue
+		case section == "[Interface]" && key == "Address":
+			config.address = value
+		case section == "[Peer]" && key == "AllowedIPs":
+			for _, ip := range strings.Split(value, ",") {
+				if ip = strings.TrimSpace(ip); !slices.Contains(config.allowedIPs, ip) {
+					config.allowedIPs = append(config.allowedIPs, ip)
+				}
+			}
+		case section == "[Peer]" && key == "Endpoint" && config.endpoint == "":
+			config.endpoint = value
+		}
+
+		lines = append(lines, line)
+	}
+
+	config.content = strings.Join(lines, "\n")
+	return config
+}
@@ -0,0 +1,50 @@
+package peer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseConfig(t *testing.T) {
+	raw := `[Interface]
+Address = 10.105.0.5/16
+PrivateKey = <%PrivateKey%>
+
+[Peer]
+PublicKey = abc
+AllowedIPs = 10.105.0.0/16
+Endpoint = 1.2.3.4:51820
+
+[Peer]
+PublicKey = def
+AllowedIPs = 10.105.0.0/16
+Endpoint = 5.6.7.8:51820
+`
+
+	config := parseConfig(raw, "secret")
+
+	if config.address != "10.105.0.5/16" {
+		t.Errorf("expect address 10.105.0.5/16, got %s", config.address)
+	}
+	if len(config.allowedIPs) != 1 || config.allowedIPs[0] != "10.105.0.0/16" {
+		t.Errorf("expect allowed IPs [10.105.0.0/16], got %v", config.allowedIPs)
+	}
+	if config.endpoint != "1.2.3.4:51820" {
+		t.Errorf("expect first endpoint, got %s", config.endpoint)
+	}
+	if strings.Count(config.content, "PrivateKey = ") != 1 || !strings.Contains(config.content, "PrivateKey = secret") {
+		t.Errorf("expect a single private key line, got:\n%s", config.content)
+	}
+}
+
+func TestGenerateKeyPair(t *testing.T) {
+	privateKey, publicKey, err := generateKeyPair()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 32 bytes keys, base64 encoded
+	if len(privateKey) != 44 || len(publicKey) != 44 {
+		t.Errorf("expect 44 chars keys, got %d and %d", len(privateKey), len(publicKey))
+	}
+}
@@ -5,9 +5,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 	"go.clever-cloud.com/terraform-provider/pkg/provider"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 )
@@ -26,11 +28,42 @@ func (r *ResourceNodeJS) Configure(ctx context.Context, req resource.ConfigureRe
 	if ok {
 		r.cc = provider.Client()
 		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
 	}
 
 	tflog.Debug(ctx, "AFTER CONFIGURED", map[string]interface{}{"cc": r.cc == nil, "org": r.org})
 }
 
+// Check the configuration does not contradict itself
+func (r *ResourceNodeJS) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := NodeJS{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(config.Validate()...)
+}
+
+// Check the plan against the product catalog
+func (r *ResourceNodeJS) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction or provider not configured yet
+	if req.Plan.Raw.IsNull() || r.cc == nil {
+		return
+	}
+
+	plan := NodeJS{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(application.ValidateRuntime(ctx, r.cc, "node", "Node", plan.Runtime)...)
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+}
+
 // Create a new resource
 func (r *ResourceNodeJS) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	plan := NodeJS{}
@@ -40,13 +73,23 @@ func (r *ResourceNodeJS) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	vhosts := []string{}
 	resp.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	instance := application.LookupInstance(ctx, r.cc, "node", "Node", resp.Diagnostics)
+	instance, diags := application.LookupInstance(ctx, r.cc, "node", "Node", plan.TargetInstanceVersion())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -62,6 +105,13 @@ func (r *ResourceNodeJS) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
 	createReq := application.CreateReq{
 		Client:       r.cc,
 		Organization: r.org,
@@ -72,6 +122,7 @@ func (r *ResourceNodeJS) Create(ctx context.Context, req resource.CreateRequest,
 			InstanceType:    instance.Type,
 			InstanceVariant: instance.Variant.ID,
 			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
 			MinFlavor:       plan.SmallestFlavor.ValueString(),
 			MaxFlavor:       plan.BiggestFlavor.ValueString(),
 			MinInstances:    plan.MinInstanceCount.ValueInt64(),
@@ -79,27 +130,37 @@ func (r *ResourceNodeJS) Create(ctx context.Context, req resource.CreateRequest,
 			StickySessions:  plan.StickySessions.ValueBool(),
 			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
 			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
 		},
 		Environment:  environment,
 		VHosts:       vhosts,
 		Deployment:   plan.toDeployment(),
 		Dependencies: dependencies,
+		Tags:         tags,
+		Running:      plan.Running.ValueBool(),
 	}
 
 	createRes, diags := application.CreateApp(ctx, createReq)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// once created, the application is saved even on partial failure so it gets tainted
+	if createRes == nil {
 		return
 	}
 
 	// TODO set fields
 	plan.ID = pkg.FromStr(createRes.Application.ID)
+	plan.ResolvedInstanceVersion = pkg.FromStr(createRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(createRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(createRes.Application.WebhookSecret)
 	plan.DeployURL = pkg.FromStr(createRes.Application.DeployURL)
 	plan.VHost = pkg.FromStr(createRes.Application.Vhosts[0].Fqdn)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
-		return
+		// attributes left unknown by a partial failure are saved as null
+		resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
 	}
 }
 
@@ -114,6 +175,15 @@ func (r *ResourceNodeJS) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	readTimeout, diags := app.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	/*appRes := tmp.GetApp(ctx, r.cc, r.org, app.ID.ValueString())
 	if appRes.IsNotFoundError() {
 		diags = resp.State.SetAttribute(ctx, path.Root("id"), types.StringUnknown)
@@ -138,6 +208,21 @@ func (r *ResourceNodeJS) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	app.ResolvedInstanceVersion = pkg.FromStr(appRes.App.Instance.Version)
+	app.CancelOnPush = pkg.FromBool(appRes.App.CancelOnPush)
+	app.Homogeneous = pkg.FromBool(appRes.App.Homogeneous)
+	app.SeparateBuild = pkg.FromBool(appRes.App.SeparateBuild)
+	app.Running = appRes.Running(app.Running)
+	app.WebhookURL = pkg.FromStr(appRes.App.WebhookURL)
+	app.WebhookSecret = pkg.FromStr(appRes.App.WebhookSecret)
+
+	app.TagsAll = pkg.FromSetString(appRes.Tags)
+	app.Tags, diags = pkg.TagsFromRemote(ctx, appRes.Tags, app.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	app.DeployURL = pkg.FromStr(appRes.App.DeployURL)
 	app.VHost = pkg.FromStr(appRes.App.Vhosts[0].Fqdn)
 
@@ -150,7 +235,115 @@ func (r *ResourceNodeJS) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update resource
 func (r *ResourceNodeJS) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
-	// TODO
+	plan := NodeJS{}
+	state := NodeJS{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "node", "Node", plan.TargetInstanceVersion())
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	environment := plan.toEnv(ctx, res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	vhosts := []string{}
+	if res.Diagnostics.Append(plan.AdditionalVHosts.ElementsAs(ctx, &vhosts, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	dependencies := []string{}
+	if res.Diagnostics.Append(plan.Dependencies.ElementsAs(ctx, &dependencies, false)...); res.Diagnostics.HasError() {
+		return
+	}
+
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
+	updateAppReq := application.UpdateReq{
+		ID:           state.ID.ValueString(),
+		Client:       r.cc,
+		Organization: r.org,
+		Application: tmp.UpdateAppReq{
+			Name:            plan.Name.ValueString(),
+			Deploy:          "git",
+			Description:     plan.Description.ValueString(),
+			InstanceType:    instance.Type,
+			InstanceVariant: instance.Variant.ID,
+			InstanceVersion: instance.Version,
+			BuildFlavor:     plan.BuildFlavor.ValueString(),
+			MinFlavor:       plan.SmallestFlavor.ValueString(),
+			MaxFlavor:       plan.BiggestFlavor.ValueString(),
+			MinInstances:    plan.MinInstanceCount.ValueInt64(),
+			MaxInstances:    plan.MaxInstanceCount.ValueInt64(),
+			StickySessions:  plan.StickySessions.ValueBool(),
+			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
+			Zone:            plan.Region.ValueString(),
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
+		},
+		Environment:  environment,
+		VHosts:       vhosts,
+		Deployment:   plan.toDeployment(),
+		Dependencies: dependencies,
+		Tags:         tags,
+		Running:      plan.Running.ValueBool(),
+	}
+
+	updateAppRes, diags := application.UpdateApp(ctx, updateAppReq)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.ResolvedInstanceVersion = pkg.FromStr(updateAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(updateAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(updateAppRes.Application.WebhookSecret)
+
+	hasDefaultVHost := pkg.HasSome(updateAppReq.VHosts, func(vhost string) bool {
+		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+	})
+	if hasDefaultVHost {
+		cleverapps := *pkg.First(vhosts, func(vhost string) bool {
+			return pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		})
+		plan.VHost = pkg.FromStr(cleverapps)
+	} else {
+		plan.VHost = types.StringNull()
+	}
+
+	vhostsWithoutDefault := pkg.Filter(updateAppReq.VHosts, func(vhost string) bool {
+		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
+		return !ok
+	})
+	if len(vhostsWithoutDefault) > 0 {
+		plan.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
+	} else {
+		plan.AdditionalVHosts = types.ListNull(types.StringType)
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, plan)...)
 }
 
 // Delete resource
@@ -162,6 +355,21 @@ func (r *ResourceNodeJS) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(app.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := app.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "NodeJS DELETE", map[string]interface{}{"app": app})
 
 	res := tmp.DeleteApp(ctx, r.cc, r.org, app.ID.ValueString())
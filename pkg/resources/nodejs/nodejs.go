@@ -8,8 +8,9 @@ import (
 )
 
 type ResourceNodeJS struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceNodeJS() resource.Resource {
@@ -51,6 +51,7 @@ func TestAccNodejs_basic(t *testing.T) {
 			"dependencies":       []string{},
 		}),
 		helper.SetBlockValues("hooks", map[string]any{"post_build": "echo \"build is OK!\""}),
+		helper.SetBlockValues("timeouts", map[string]any{"create": "15m", "delete": "5m"}),
 	)
 	nodejsBlock2 := helper.NewRessource(
 		"clevercloud_nodejs",
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"go.clever-cloud.com/terraform-provider/pkg"
 	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 	"go.clever-cloud.com/terraform-provider/pkg/helper"
 	"go.clever-cloud.com/terraform-provider/pkg/provider"
 	"go.clever-cloud.com/terraform-provider/pkg/tmp"
@@ -28,11 +29,42 @@ func (r *ResourcePHP) Configure(ctx context.Context, req resource.ConfigureReque
 	if ok {
 		r.cc = provider.Client()
 		r.org = provider.Organization()
+		r.defaultTags = provider.DefaultTags()
 	}
 
 	tflog.Debug(ctx, "AFTER CONFIGURED", map[string]interface{}{"cc": r.cc == nil, "org": r.org})
 }
 
+// Check the configuration does not contradict itself
+func (r *ResourcePHP) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := PHP{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(config.Validate()...)
+}
+
+// Check the plan against the product catalog
+func (r *ResourcePHP) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	// resource destruction or provider not configured yet
+	if req.Plan.Raw.IsNull() || r.cc == nil {
+		return
+	}
+
+	plan := PHP{}
+
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.Append(application.ValidateRuntime(ctx, r.cc, "php", "PHP", plan.Runtime)...)
+	res.Diagnostics.Append(pkg.PlanTagsAll(ctx, &res.Plan, r.defaultTags)...)
+}
+
 // Create a new resource
 func (r *ResourcePHP) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "ResourcePHP.Create()")
@@ -41,7 +73,17 @@ func (r *ResourcePHP) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
-	instance := application.LookupInstance(ctx, r.cc, "php", "PHP", resp.Diagnostics)
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	instance, diags := application.LookupInstance(ctx, r.cc, "php", "PHP", plan.TargetInstanceVersion())
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -57,6 +99,13 @@ func (r *ResourcePHP) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
 	createAppReq := application.CreateReq{
 		Client:       r.cc,
 		Organization: r.org,
@@ -75,28 +124,37 @@ func (r *ResourcePHP) Create(ctx context.Context, req resource.CreateRequest, re
 			StickySessions:  plan.StickySessions.ValueBool(),
 			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
 			Zone:            plan.Region.ValueString(),
-			CancelOnPush:    false,
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
 		},
 		Environment: environment,
 		VHosts:      vhosts,
 		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
 	}
 
 	createAppRes, diags := application.CreateApp(ctx, createAppReq)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	// once created, the application is saved even on partial failure so it gets tainted
+	if createAppRes == nil {
 		return
 	}
 
 	tflog.Debug(ctx, "BUILD FLAVOR RES", map[string]interface{}{"flavor": createAppRes.Application.BuildFlavor.Name})
 	plan.ID = pkg.FromStr(createAppRes.Application.ID)
+	plan.ResolvedInstanceVersion = pkg.FromStr(createAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(createAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(createAppRes.Application.WebhookSecret)
 	plan.DeployURL = pkg.FromStr(createAppRes.Application.DeployURL)
 	plan.VHost = pkg.FromStr(createAppRes.Application.Vhosts[0].Fqdn)
 	//plan.AdditionalVHosts = createAppRes.Application.Vhosts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 	if resp.Diagnostics.HasError() {
-		return
+		// attributes left unknown by a partial failure are saved as null
+		resp.Diagnostics.Append(pkg.NullUnknowns(&resp.State)...)
 	}
 }
 
@@ -108,6 +166,15 @@ func (r *ResourcePHP) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	appPHP, diags := application.ReadApp(ctx, r.cc, r.org, state.ID.ValueString())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -118,6 +185,21 @@ func (r *ResourcePHP) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	state.ResolvedInstanceVersion = pkg.FromStr(appPHP.App.Instance.Version)
+	state.CancelOnPush = pkg.FromBool(appPHP.App.CancelOnPush)
+	state.Homogeneous = pkg.FromBool(appPHP.App.Homogeneous)
+	state.SeparateBuild = pkg.FromBool(appPHP.App.SeparateBuild)
+	state.Running = appPHP.Running(state.Running)
+	state.WebhookURL = pkg.FromStr(appPHP.App.WebhookURL)
+	state.WebhookSecret = pkg.FromStr(appPHP.App.WebhookSecret)
+
+	state.TagsAll = pkg.FromSetString(appPHP.Tags)
+	state.Tags, diags = pkg.TagsFromRemote(ctx, appPHP.Tags, state.Tags, r.defaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	state.Name = pkg.FromStr(appPHP.App.Name)
 	state.Description = pkg.FromStr(appPHP.App.Description)
 	state.MinInstanceCount = pkg.FromI(int64(appPHP.App.Instance.MinInstances))
@@ -148,10 +230,7 @@ func (r *ResourcePHP) Read(ctx context.Context, req resource.ReadRequest, resp *
 		state.VHost = types.StringNull()
 	}
 
-	vhostsWithoutDefault := pkg.Filter(vhosts, func(vhost string) bool {
-		ok := pkg.VhostCleverAppsRegExp.MatchString(vhost)
-		return !ok
-	})
+	vhostsWithoutDefault := application.TrackedVHosts(ctx, vhosts, state.AdditionalVHosts)
 	if len(vhostsWithoutDefault) > 0 {
 		state.AdditionalVHosts = pkg.FromListString(vhostsWithoutDefault)
 	} else {
@@ -170,12 +249,22 @@ func (r *ResourcePHP) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	state := helper.StateFrom[PHP](ctx, req.State, res.Diagnostics)
 	if res.Diagnostics.HasError() {
 		return
 	}
 
-	instance := application.LookupInstance(ctx, r.cc, "php", "PHP", res.Diagnostics)
+	instance, diags := application.LookupInstance(ctx, r.cc, "php", "PHP", plan.TargetInstanceVersion())
+	res.Diagnostics.Append(diags...)
 	if res.Diagnostics.HasError() {
 		return
 	}
@@ -190,6 +279,13 @@ func (r *ResourcePHP) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	tags, diags := pkg.TagsWithDefaults(ctx, plan.Tags, r.defaultTags)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = pkg.FromSetString(tags)
+
 	updateAppReq := application.UpdateReq{
 		ID:           state.ID.ValueString(),
 		Client:       r.cc,
@@ -209,18 +305,25 @@ func (r *ResourcePHP) Update(ctx context.Context, req resource.UpdateRequest, re
 			StickySessions:  plan.StickySessions.ValueBool(),
 			ForceHttps:      application.FromForceHTTPS(plan.RedirectHTTPS.ValueBool()),
 			Zone:            plan.Region.ValueString(),
-			CancelOnPush:    false,
+			CancelOnPush:    plan.CancelOnPush.ValueBool(),
+			Homogeneous:     plan.Homogeneous.ValueBool(),
+			SeparateBuild:   plan.SeparateBuild.ValueBool(),
 		},
 		Environment: environment,
 		VHosts:      vhosts,
 		Deployment:  plan.toDeployment(),
+		Tags:        tags,
+		Running:     plan.Running.ValueBool(),
 	}
 
-	_, diags := application.UpdateApp(ctx, updateAppReq)
+	updateAppRes, diags := application.UpdateApp(ctx, updateAppReq)
 	res.Diagnostics.Append(diags...)
 	if res.Diagnostics.HasError() {
 		return
 	}
+	plan.ResolvedInstanceVersion = pkg.FromStr(updateAppRes.Application.Instance.Version)
+	plan.WebhookURL = pkg.FromStr(updateAppRes.Application.WebhookURL)
+	plan.WebhookSecret = pkg.FromStr(updateAppRes.Application.WebhookSecret)
 
 	hasDefaultVHost := pkg.HasSome(updateAppReq.VHosts, func(vhost string) bool {
 		return pkg.VhostCleverAppsRegExp.MatchString(vhost)
@@ -259,6 +362,21 @@ func (r *ResourcePHP) Delete(ctx context.Context, req resource.DeleteRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(attributes.CheckDeletionProtection(state.DeletionProtection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "PHP DELETE", map[string]interface{}{"state": state})
 
 	res := tmp.DeleteApp(ctx, r.cc, r.org, state.ID.ValueString())
@@ -8,8 +8,9 @@ import (
 )
 
 type ResourcePHP struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourcePHP() resource.Resource {
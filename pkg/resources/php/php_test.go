@@ -42,6 +42,8 @@ func TestAccPHP_basic(t *testing.T) {
 			"smallest_flavor":    "XS",
 			"biggest_flavor":     "M",
 			"php_version":        "8",
+			"cancel_on_push":     true,
+			"tags":               [1]string{"team:terraform"},
 			"additional_vhosts":  [1]string{"toto-tf5283457829345.com"},
 		}))
 
@@ -59,6 +61,11 @@ func TestAccPHP_basic(t *testing.T) {
 				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*$`)),
 				resource.TestMatchResourceAttr(fullName, "deploy_url", regexp.MustCompile(`^git\+ssh.*\.git$`)),
 				resource.TestCheckResourceAttr(fullName, "region", "par"),
+				resource.TestCheckResourceAttrSet(fullName, "resolved_instance_version"),
+				resource.TestCheckResourceAttr(fullName, "cancel_on_push", "true"),
+				resource.TestCheckResourceAttrSet(fullName, "webhook_url"),
+				resource.TestCheckResourceAttr(fullName, "tags.#", "1"),
+				resource.TestCheckTypeSetElemAttr(fullName, "tags.*", "team:terraform"),
 			),
 		}, {
 			ResourceName: rName,
@@ -69,6 +76,18 @@ func TestAccPHP_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(fullName, "min_instance_count", "2"),
 				resource.TestCheckResourceAttr(fullName, "max_instance_count", "6"),
 			),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock.SetOneValue("running", false)).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(fullName, "running", "false"),
+			),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock.SetOneValue("running", true)).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr(fullName, "running", "true"),
+			),
 		}},
 		CheckDestroy: func(state *terraform.State) error {
 			for _, resource := range state.RootModule().Resources {
@@ -89,3 +108,45 @@ func TestAccPHP_basic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPHP_invalidPlan(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-php-%d", time.Now().UnixMilli())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	phpBlock := helper.NewRessource(
+		"clevercloud_php",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 2,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "M",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock.SetOneValue("min_instance_count", 3)).String(),
+			PlanOnly:     true,
+			ExpectError:  regexp.MustCompile(`min_instance_count \(3\) is greater than max_instance_count \(2\)`),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock.SetOneValue("min_instance_count", 1).SetOneValue("biggest_flavor", "XXXXL")).String(),
+			PlanOnly:     true,
+			ExpectError:  regexp.MustCompile(`flavor 'XXXXL' is not available`),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock.SetOneValue("biggest_flavor", "M").SetOneValue("region", "nowhere")).String(),
+			PlanOnly:     true,
+			ExpectError:  regexp.MustCompile(`region 'nowhere' does not exist`),
+		}},
+	})
+}
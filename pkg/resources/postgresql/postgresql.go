@@ -4,18 +4,38 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
 	"go.clever-cloud.dev/client"
 )
 
 type ResourcePostgreSQL struct {
-	cc  *client.Client
-	org string
+	addon.Resource[PostgreSQL, *PostgreSQL, tmp.PostgreSQL]
 }
 
 func NewResourcePostgreSQL() resource.Resource {
-	return &ResourcePostgreSQL{}
+	return &ResourcePostgreSQL{addon.Resource[PostgreSQL, *PostgreSQL, tmp.PostgreSQL]{
+		Engine:   engine,
+		TypeName: "postgresql",
+		Options:  (*PostgreSQL).options,
+	}}
 }
 
-func (r *ResourcePostgreSQL) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
-	res.TypeName = req.ProviderTypeName + "_postgresql"
+var engine = addon.Engine[*PostgreSQL, tmp.PostgreSQL]{
+	ProviderID: "postgresql-addon",
+	GetDetail: func(ctx context.Context, cc *client.Client, _, addonID string) client.Response[tmp.PostgreSQL] {
+		return tmp.GetPostgreSQL(ctx, cc, addonID)
+	},
+	Status: func(detail *tmp.PostgreSQL) string { return detail.Status },
+	MapDetail: func(pg *PostgreSQL, detail *tmp.PostgreSQL) {
+		pg.Host = pkg.FromStr(detail.Host)
+		pg.Port = pkg.FromI(int64(detail.Port))
+		pg.Database = pkg.FromStr(detail.Database)
+		pg.User = pkg.FromStr(detail.User)
+		pg.Password = pkg.FromStr(detail.Password)
+		pg.Version = addon.Version(pg.Version, detail.Version)
+		pg.Encryption = pkg.FromBool(addon.FeatureEnabled(detail.Features, addon.OptionEncryption))
+		pg.DirectHostOnly = pkg.FromBool(addon.FeatureEnabled(detail.Features, addon.OptionDirectHostOnly))
+	},
 }
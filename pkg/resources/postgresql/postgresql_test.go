@@ -72,6 +72,8 @@ func TestAccPostgreSQL_basic(t *testing.T) {
 				resource.TestMatchResourceAttr(fullName, "database", regexp.MustCompile(`^[a-zA-Z0-9]+$`)),
 				resource.TestMatchResourceAttr(fullName, "user", regexp.MustCompile(`^[a-zA-Z0-9]+$`)),
 				resource.TestMatchResourceAttr(fullName, "password", regexp.MustCompile(`^[a-zA-Z0-9]+$`)),
+				resource.TestCheckResourceAttrSet(fullName, "version"),
+				resource.TestCheckResourceAttr(fullName, "encryption", "false"),
 			),
 		}},
 	})
@@ -136,3 +138,129 @@ func TestAccPostgreSQL_RefreshDeleted(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPostgreSQL_existing(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-pg-%d", time.Now().UnixMilli())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	postgresqlBlock := helper.NewRessource(
+		"clevercloud_postgresql",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":   rName,
+			"region": "par",
+			"plan":   "dev",
+		}))
+	duplicateBlock := helper.NewRessource(
+		"clevercloud_postgresql",
+		rName+"-duplicate",
+		helper.SetKeyValues(map[string]any{
+			"name":   rName,
+			"region": "par",
+			"plan":   "dev",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(postgresqlBlock).String(),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(postgresqlBlock, duplicateBlock).String(),
+			ExpectError:  regexp.MustCompile(`addon already exists`),
+		}},
+	})
+}
+
+func TestAccPostgreSQL_deletionProtection(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-pg-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_postgresql.%s", rName)
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	postgresqlBlock := helper.NewRessource(
+		"clevercloud_postgresql",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":                rName,
+			"region":              "par",
+			"plan":                "dev",
+			"deletion_protection": true,
+		}))
+	protectedConfig := providerBlock.Append(postgresqlBlock).String()
+	unprotectedConfig := providerBlock.Append(postgresqlBlock.SetOneValue("deletion_protection", false)).String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       protectedConfig,
+			Check:        resource.TestCheckResourceAttr(fullName, "deletion_protection", "true"),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append().String(),
+			ExpectError:  regexp.MustCompile(`deletion protection is enabled`),
+		}, {
+			ResourceName: rName,
+			Config:       unprotectedConfig,
+			Check:        resource.TestCheckResourceAttr(fullName, "deletion_protection", "false"),
+		}},
+	})
+}
+
+func TestAccPostgreSQL_update(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-pg-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_postgresql.%s", rName)
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	postgresqlBlock := helper.NewRessource(
+		"clevercloud_postgresql",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":   rName,
+			"region": "par",
+			"plan":   "dev",
+		}))
+	initialConfig := providerBlock.Append(postgresqlBlock).String()
+	updatedConfig := providerBlock.Append(postgresqlBlock.
+		SetOneValue("name", rName+"-renamed").
+		SetOneValue("plan", "xxs_sml"),
+	).String()
+
+	var addonID string
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       initialConfig,
+			Check: func(state *terraform.State) error {
+				addonID = state.RootModule().Resources[fullName].Primary.ID
+				return nil
+			},
+		}, {
+			ResourceName: rName,
+			Config:       updatedConfig,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				// renamed and migrated in place
+				resource.TestCheckResourceAttrPtr(fullName, "id", &addonID),
+				resource.TestCheckResourceAttr(fullName, "name", rName+"-renamed"),
+				resource.TestCheckResourceAttr(fullName, "plan", "xxs_sml"),
+			),
+		}},
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
 	"go.clever-cloud.com/terraform-provider/pkg/attributes"
 )
 
@@ -17,6 +19,11 @@ type PostgreSQL struct {
 	Database types.String `tfsdk:"database"`
 	User     types.String `tfsdk:"user"`
 	Password types.String `tfsdk:"password"`
+
+	// Options
+	Version        types.String `tfsdk:"version"`
+	Encryption     types.Bool   `tfsdk:"encryption"`
+	DirectHostOnly types.Bool   `tfsdk:"direct_host_only"`
 }
 
 //go:embed doc.md
@@ -27,15 +34,36 @@ func (r ResourcePostgreSQL) Schema(_ context.Context, req resource.SchemaRequest
 		Version:             0,
 		MarkdownDescription: resourcePostgresqlDoc,
 		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
-			"host":     schema.StringAttribute{Computed: true, MarkdownDescription: "Database host, used to connect to"},
-			"port":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Database port"},
-			"database": schema.StringAttribute{Computed: true, MarkdownDescription: "Database name on the PostgreSQL server"},
-			"user":     schema.StringAttribute{Computed: true, MarkdownDescription: "Login username"},
-			"password": schema.StringAttribute{Computed: true, MarkdownDescription: "Login password"},
+			"version":          attributes.VersionAttribute,
+			"encryption":       attributes.EncryptionAttribute,
+			"direct_host_only": attributes.DirectHostOnlyAttribute,
+			"host":             schema.StringAttribute{Computed: true, MarkdownDescription: "Database host, used to connect to"},
+			"port":             schema.Int64Attribute{Computed: true, MarkdownDescription: "Database port"},
+			"database":         schema.StringAttribute{Computed: true, MarkdownDescription: "Database name on the PostgreSQL server"},
+			"user":             schema.StringAttribute{Computed: true, MarkdownDescription: "Login username"},
+			"password":         schema.StringAttribute{Computed: true, MarkdownDescription: "Login password"},
 		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
 	}
 }
 
+func (pg PostgreSQL) options() map[string]string {
+	options := map[string]string{}
+
+	pkg.IfIsSet(pg.Version, func(version string) {
+		options[addon.OptionVersion] = version
+	})
+	// features are only sent when enabled, not every version supports them
+	if pg.Encryption.ValueBool() {
+		options[addon.OptionEncryption] = "true"
+	}
+	if pg.DirectHostOnly.ValueBool() {
+		options[addon.OptionDirectHostOnly] = "true"
+	}
+
+	return options
+}
+
 // https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
 func (r ResourcePostgreSQL) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
 	return map[int64]resource.StateUpgrader{}
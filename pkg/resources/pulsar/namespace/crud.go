@@ -0,0 +1,255 @@
+package namespace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/pulsar"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourcePulsarNamespacePolicy) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourcePulsarNamespacePolicy.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+func (r *ResourcePulsarNamespacePolicy) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	config := PulsarNamespacePolicy{}
+
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RetentionTimeMinutes.IsNull() != config.RetentionSizeMB.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root("retention_size_mb"), "incomplete retention", "retention_time_minutes and retention_size_mb must be set together")
+	}
+}
+
+// Create a new resource
+func (r *ResourcePulsarNamespacePolicy) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	policy := PulsarNamespacePolicy{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := policy.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	admin, diags := r.adminClient(ctx, policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(apply(ctx, admin, PulsarNamespacePolicy{}, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy.ID = policy.PulsarID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+// Read resource information
+func (r *ResourcePulsarNamespacePolicy) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Pulsar namespace policy READ", map[string]interface{}{"request": req})
+
+	var policy PulsarNamespacePolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := policy.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	admin, diags := r.adminClient(ctx, policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retention, err := admin.GetRetention(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get namespace retention", err.Error())
+		return
+	}
+	if retention != nil {
+		policy.RetentionTimeMinutes = pkg.FromI(retention.RetentionTimeInMinutes)
+		policy.RetentionSizeMB = pkg.FromI(retention.RetentionSizeInMB)
+	} else {
+		policy.RetentionTimeMinutes = types.Int64Null()
+		policy.RetentionSizeMB = types.Int64Null()
+	}
+
+	ttl, err := admin.GetMessageTTL(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get namespace message TTL", err.Error())
+		return
+	}
+	if ttl != nil {
+		policy.TTLSeconds = pkg.FromI(*ttl)
+	} else {
+		policy.TTLSeconds = types.Int64Null()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, policy)...)
+}
+
+// Update resource
+func (r *ResourcePulsarNamespacePolicy) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := PulsarNamespacePolicy{}
+	state := PulsarNamespacePolicy{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, attributes.DefaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	admin, diags := r.adminClient(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(apply(ctx, admin, state, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete resource
+func (r *ResourcePulsarNamespacePolicy) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var policy PulsarNamespacePolicy
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := policy.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Pulsar namespace policy DELETE", map[string]interface{}{"policy": policy})
+
+	admin, diags := r.adminClient(ctx, policy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// removing the managed policies restores the cluster defaults
+	resp.Diagnostics.Append(apply(ctx, admin, policy, PulsarNamespacePolicy{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourcePulsarNamespacePolicy) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Save the import identifier in the id and pulsar_id attributes
+	// and call Read() to fill fields
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pulsar_id"), req.ID)...)
+}
+
+func (r *ResourcePulsarNamespacePolicy) adminClient(ctx context.Context, policy PulsarNamespacePolicy) (*pulsar.AdminClient, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	admin, err := pulsar.AdminClientFor(ctx, r.cc, r.org, policy.PulsarID.ValueString())
+	if err != nil {
+		diags.AddError("failed to setup Pulsar admin client", err.Error())
+	}
+
+	return admin, diags
+}
+
+// apply the changed policies, the ones no longer set are removed
+func apply(ctx context.Context, admin *pulsar.AdminClient, state, plan PulsarNamespacePolicy) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if !plan.RetentionTimeMinutes.Equal(state.RetentionTimeMinutes) || !plan.RetentionSizeMB.Equal(state.RetentionSizeMB) {
+		var err error
+		if !plan.RetentionTimeMinutes.IsNull() {
+			err = admin.SetRetention(ctx, pulsar.Retention{
+				RetentionTimeInMinutes: plan.RetentionTimeMinutes.ValueInt64(),
+				RetentionSizeInMB:      plan.RetentionSizeMB.ValueInt64(),
+			})
+		} else {
+			err = admin.RemoveRetention(ctx)
+		}
+		if err != nil {
+			diags.AddError("failed to update namespace retention", err.Error())
+		}
+	}
+
+	if !plan.TTLSeconds.Equal(state.TTLSeconds) {
+		var err error
+		if !plan.TTLSeconds.IsNull() {
+			err = admin.SetMessageTTL(ctx, plan.TTLSeconds.ValueInt64())
+		} else {
+			err = admin.RemoveMessageTTL(ctx)
+		}
+		if err != nil {
+			diags.AddError("failed to update namespace message TTL", err.Error())
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,21 @@
+package namespace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourcePulsarNamespacePolicy struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourcePulsarNamespacePolicy() resource.Resource {
+	return &ResourcePulsarNamespacePolicy{}
+}
+
+func (r *ResourcePulsarNamespacePolicy) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_pulsar_namespace_policy"
+}
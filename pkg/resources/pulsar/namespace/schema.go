@@ -0,0 +1,69 @@
+package namespace
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type PulsarNamespacePolicy struct {
+	ID                   types.String `tfsdk:"id"`
+	PulsarID             types.String `tfsdk:"pulsar_id"`
+	RetentionTimeMinutes types.Int64  `tfsdk:"retention_time_minutes"`
+	RetentionSizeMB      types.Int64  `tfsdk:"retention_size_mb"`
+	TTLSeconds           types.Int64  `tfsdk:"ttl_seconds"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+//go:embed doc.md
+var resourcePulsarNamespacePolicyDoc string
+
+func (r ResourcePulsarNamespacePolicy) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourcePulsarNamespacePolicyDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"pulsar_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Pulsar's reference",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"retention_time_minutes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How long acknowledged messages are retained, -1 for no limit",
+			},
+			"retention_size_mb": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "How much acknowledged messages are retained per topic, -1 for no limit",
+			},
+			"ttl_seconds": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Time to live of unacknowledged messages",
+			},
+
+			// provider
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Same as `pulsar_id`, a namespace has a single policy",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourcePulsarNamespacePolicy) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,46 @@
+package pulsar
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/pulsar"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+type ResourcePulsar struct {
+	addon.Resource[Pulsar, *Pulsar, []tmp.EnvVar]
+}
+
+func NewResourcePulsar() resource.Resource {
+	return &ResourcePulsar{addon.Resource[Pulsar, *Pulsar, []tmp.EnvVar]{
+		Engine:      engine,
+		TypeName:    "pulsar",
+		CheckActive: checkActive,
+	}}
+}
+
+// Pulsar has no status, its namespace and credentials are exposed by the addon env
+var engine = addon.Engine[*Pulsar, []tmp.EnvVar]{
+	ProviderID: "addon-pulsar",
+	UseRealID:  true,
+	GetDetail:  tmp.GetAddonEnv,
+	MapDetail: func(p *Pulsar, env *[]tmp.EnvVar) {
+		creds := pulsar.FromEnvVars(*env)
+
+		p.Tenant = pkg.FromStr(creds.Tenant)
+		p.Namespace = pkg.FromStr(creds.Namespace)
+		p.BinaryURL = pkg.FromStr(creds.BinaryURL)
+		p.HTTPURL = pkg.FromStr(creds.HTTPURL)
+		p.Token = pkg.FromStr(creds.Token)
+	},
+}
+
+func checkActive(p *Pulsar) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if p.Token.IsNull() || p.Token.IsUnknown() {
+		diags.AddError("cannot get Pulsar infos", "missing ADDON_PULSAR_TOKEN env var on created addon")
+	}
+	return diags
+}
@@ -0,0 +1,109 @@
+package pulsar_test
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccPulsar_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-test-pulsar-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_pulsar.%s", rName)
+	topicName := fmt.Sprintf("clevercloud_pulsar_topic.%s", rName)
+	policyName := fmt.Sprintf("clevercloud_pulsar_namespace_policy.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	pulsarBlock := helper.NewRessource(
+		"clevercloud_pulsar",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":   rName,
+			"region": "par",
+		}))
+	topicBlock := helper.NewRessource(
+		"clevercloud_pulsar_topic",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"pulsar_id":  fmt.Sprintf("${%s.id}", fullName),
+			"name":       "events",
+			"partitions": 2,
+		}))
+	policyBlock := helper.NewRessource(
+		"clevercloud_pulsar_namespace_policy",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"pulsar_id":              fmt.Sprintf("${%s.id}", fullName),
+			"retention_time_minutes": 60,
+			"retention_size_mb":      -1,
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				if resource.Type != "clevercloud_pulsar" {
+					continue
+				}
+
+				res := tmp.GetAddon(context.Background(), cc, org, resource.Primary.ID)
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				return fmt.Errorf("expect resource '%s' to be deleted", resource.Primary.ID)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(pulsarBlock, topicBlock, policyBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^pulsar_.*`)),
+				resource.TestCheckResourceAttrSet(fullName, "tenant"),
+				resource.TestCheckResourceAttrSet(fullName, "namespace"),
+				resource.TestMatchResourceAttr(fullName, "binary_url", regexp.MustCompile(`^pulsar\+ssl://.*`)),
+				resource.TestMatchResourceAttr(fullName, "http_url", regexp.MustCompile(`^https://.*`)),
+				resource.TestCheckResourceAttrSet(fullName, "token"),
+				resource.TestMatchResourceAttr(topicName, "full_name", regexp.MustCompile(`^persistent://.*/events$`)),
+				resource.TestCheckResourceAttr(topicName, "partitions", "2"),
+				resource.TestCheckResourceAttr(policyName, "retention_time_minutes", "60"),
+				resource.TestCheckResourceAttr(policyName, "retention_size_mb", "-1"),
+				resource.TestCheckNoResourceAttr(policyName, "ttl_seconds"),
+			),
+		}, {
+			ResourceName: rName,
+			Config:       providerBlock.Append(pulsarBlock, topicBlock, policyBlock.SetOneValue("ttl_seconds", 3600)).String(),
+			Check:        resource.TestCheckResourceAttr(policyName, "ttl_seconds", "3600"),
+		}, {
+			ResourceName:      topicName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		}},
+	})
+}
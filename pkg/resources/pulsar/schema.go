@@ -0,0 +1,98 @@
+package pulsar
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Pulsar struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	CreationDate types.Int64  `tfsdk:"creation_date"`
+	Region       types.String `tfsdk:"region"`
+	Tags         types.Set    `tfsdk:"tags"`
+	TagsAll      types.Set    `tfsdk:"tags_all"`
+	Tenant       types.String `tfsdk:"tenant"`
+	Namespace    types.String `tfsdk:"namespace"`
+	BinaryURL    types.String `tfsdk:"binary_url"`
+	HTTPURL      types.String `tfsdk:"http_url"`
+	Token        types.String `tfsdk:"token"`
+
+	AdoptExisting      types.Bool `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ToAddon and FromAddon let the addon engine work on Pulsar, which has no plan
+func (p Pulsar) ToAddon() attributes.Addon {
+	return attributes.Addon{
+		ID:                 p.ID,
+		Name:               p.Name,
+		Region:             p.Region,
+		Tags:               p.Tags,
+		TagsAll:            p.TagsAll,
+		CreationDate:       p.CreationDate,
+		AdoptExisting:      p.AdoptExisting,
+		DeletionProtection: p.DeletionProtection,
+		Timeouts:           p.Timeouts,
+	}
+}
+
+func (p *Pulsar) FromAddon(a attributes.Addon) {
+	p.ID = a.ID
+	p.Name = a.Name
+	p.Region = a.Region
+	p.Tags = a.Tags
+	p.TagsAll = a.TagsAll
+	p.CreationDate = a.CreationDate
+	p.AdoptExisting = a.AdoptExisting
+	p.DeletionProtection = a.DeletionProtection
+	p.Timeouts = a.Timeouts
+}
+
+//go:embed doc.md
+var resourcePulsarDoc string
+
+func (r ResourcePulsar) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourcePulsarDoc,
+		Attributes: map[string]schema.Attribute{
+			"name":                schema.StringAttribute{Required: true, MarkdownDescription: "Name of the service"},
+			"adopt_existing":      attributes.AdoptExistingAttribute,
+			"deletion_protection": attributes.DeletionProtectionAttribute,
+			"tags":                attributes.AddonTagsAttribute,
+			"tags_all":            attributes.AddonTagsAllAttribute,
+			"region": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("par"),
+				MarkdownDescription: "Geographical region where the data will be stored",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"id":            schema.StringAttribute{Computed: true, MarkdownDescription: "Generated unique identifier"},
+			"creation_date": schema.Int64Attribute{Computed: true, MarkdownDescription: "Date of database creation"},
+			"tenant":        schema.StringAttribute{Computed: true, MarkdownDescription: "Pulsar tenant of the namespace"},
+			"namespace":     schema.StringAttribute{Computed: true, MarkdownDescription: "Pulsar namespace dedicated to the addon"},
+			"binary_url":    schema.StringAttribute{Computed: true, MarkdownDescription: "URL used by Pulsar clients (`pulsar+ssl://`)"},
+			"http_url":      schema.StringAttribute{Computed: true, MarkdownDescription: "URL of the Pulsar HTTP and admin API"},
+			"token":         schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Token to authenticate against the namespace"},
+		},
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourcePulsar) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,186 @@
+package topic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/pulsar"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourcePulsarTopic) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourcePulsarTopic.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+// Create a new resource
+func (r *ResourcePulsarTopic) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	topic := PulsarTopic{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &topic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := topic.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	admin, diags := r.adminClient(ctx, topic)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := admin.CreateTopic(ctx, topic.Name.ValueString(), topic.Partitions.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create topic", err.Error())
+		return
+	}
+
+	topic.ID = pkg.FromStr(topic.PulsarID.ValueString() + "/" + topic.Name.ValueString())
+	topic.FullName = pkg.FromStr(admin.TopicName(topic.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, topic)...)
+}
+
+// Read resource information
+func (r *ResourcePulsarTopic) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Pulsar topic READ", map[string]interface{}{"request": req})
+
+	var topic PulsarTopic
+	resp.Diagnostics.Append(req.State.Get(ctx, &topic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := topic.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	admin, diags := r.adminClient(ctx, topic)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	partitions, exists, err := admin.GetTopicPartitions(ctx, topic.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get topic", err.Error())
+		return
+	}
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	topic.Partitions = pkg.FromI(partitions)
+	topic.FullName = pkg.FromStr(admin.TopicName(topic.Name.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, topic)...)
+}
+
+// Update resource
+func (r *ResourcePulsarTopic) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := PulsarTopic{}
+	state := PulsarTopic{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// every attribute requires a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourcePulsarTopic) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var topic PulsarTopic
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &topic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := topic.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Pulsar topic DELETE", map[string]interface{}{"topic": topic})
+
+	admin, diags := r.adminClient(ctx, topic)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := admin.DeleteTopic(ctx, topic.Name.ValueString(), topic.Partitions.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete topic", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourcePulsarTopic) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pulsarID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || pulsarID == "" || name == "" {
+		resp.Diagnostics.AddError("invalid import identifier", fmt.Sprintf("expect 'pulsar_id/name', got '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pulsar_id"), pulsarID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func (r *ResourcePulsarTopic) adminClient(ctx context.Context, topic PulsarTopic) (*pulsar.AdminClient, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	admin, err := pulsar.AdminClientFor(ctx, r.cc, r.org, topic.PulsarID.ValueString())
+	if err != nil {
+		diags.AddError("failed to setup Pulsar admin client", err.Error())
+	}
+
+	return admin, diags
+}
@@ -0,0 +1,76 @@
+package topic
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type PulsarTopic struct {
+	ID         types.String `tfsdk:"id"`
+	PulsarID   types.String `tfsdk:"pulsar_id"`
+	Name       types.String `tfsdk:"name"`
+	Partitions types.Int64  `tfsdk:"partitions"`
+	FullName   types.String `tfsdk:"full_name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+//go:embed doc.md
+var resourcePulsarTopicDoc string
+
+func (r ResourcePulsarTopic) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourcePulsarTopicDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"pulsar_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Pulsar's reference",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the topic in the Pulsar namespace",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"partitions": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				MarkdownDescription: "Number of partitions, 0 for a non partitioned topic",
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+
+			// provider
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Pulsar ID and topic name, as `pulsar_id/name`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"full_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fully qualified name of the topic (`persistent://tenant/namespace/name`)",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourcePulsarTopic) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
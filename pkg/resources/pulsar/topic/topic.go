@@ -0,0 +1,21 @@
+package topic
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourcePulsarTopic struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourcePulsarTopic() resource.Resource {
+	return &ResourcePulsarTopic{}
+}
+
+func (r *ResourcePulsarTopic) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_pulsar_topic"
+}
@@ -8,8 +8,9 @@ import (
 )
 
 type ResourcePython struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourcePython() resource.Resource {
@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceRedis struct {
+	addon.Resource[Redis, *Redis, tmp.Redis]
+}
+
+func NewResourceRedis() resource.Resource {
+	return &ResourceRedis{addon.Resource[Redis, *Redis, tmp.Redis]{
+		Engine:   engine,
+		TypeName: "redis",
+		Options:  (*Redis).options,
+	}}
+}
+
+var engine = addon.Engine[*Redis, tmp.Redis]{
+	ProviderID: "redis-addon",
+	GetDetail: func(ctx context.Context, cc *client.Client, _, addonID string) client.Response[tmp.Redis] {
+		return tmp.GetRedis(ctx, cc, addonID)
+	},
+	Status: func(detail *tmp.Redis) string { return detail.Status },
+	MapDetail: func(rd *Redis, detail *tmp.Redis) {
+		rd.Host = pkg.FromStr(detail.Host)
+		rd.Port = pkg.FromI(int64(detail.Port))
+		rd.Password = pkg.FromStr(detail.Password)
+		rd.URL = pkg.FromStr(connectionURL(detail))
+		rd.Version = addon.Version(rd.Version, detail.Version)
+	},
+}
+
+// redis://:password@host:port, Redis has no user
+func connectionURL(detail *tmp.Redis) string {
+	uri := url.URL{
+		Scheme: "redis",
+		User:   url.UserPassword("", detail.Password),
+		Host:   net.JoinHostPort(detail.Host, strconv.Itoa(detail.Port)),
+	}
+	return uri.String()
+}
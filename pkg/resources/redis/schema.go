@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/addon"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Redis struct {
+	attributes.Addon
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Password types.String `tfsdk:"password"`
+	URL      types.String `tfsdk:"url"`
+
+	// Options
+	Version types.String `tfsdk:"version"`
+}
+
+//go:embed doc.md
+var resourceRedisDoc string
+
+func (r ResourceRedis) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceRedisDoc,
+		Attributes: attributes.WithAddonCommons(map[string]schema.Attribute{
+			"version":  attributes.VersionAttribute,
+			"host":     schema.StringAttribute{Computed: true, MarkdownDescription: "Redis host, used to connect to"},
+			"port":     schema.Int64Attribute{Computed: true, MarkdownDescription: "Redis port"},
+			"password": schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Login password"},
+			"url":      schema.StringAttribute{Computed: true, Sensitive: true, MarkdownDescription: "Connection URL (`redis://`), including the password"},
+		}),
+		Blocks: attributes.WithBlockAddonCommons(map[string]schema.Block{}),
+	}
+}
+
+func (rd Redis) options() map[string]string {
+	options := map[string]string{}
+
+	pkg.IfIsSet(rd.Version, func(version string) {
+		options[addon.OptionVersion] = version
+	})
+
+	return options
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceRedis) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,22 @@
+package ruby
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceRuby struct {
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func NewResourceRuby() resource.Resource {
+	return &ResourceRuby{}
+}
+
+func (r *ResourceRuby) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_ruby"
+}
@@ -0,0 +1,93 @@
+package ruby
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Ruby struct {
+	attributes.Runtime
+	RubyVersion  types.String `tfsdk:"ruby_version"`
+	RailsEnv     types.String `tfsdk:"rails_env"`
+	RakeGoals    types.String `tfsdk:"rake_goals"`
+	SidekiqFiles types.String `tfsdk:"sidekiq_files"`
+}
+
+//go:embed doc.md
+var rubyDoc string
+
+func (r ResourceRuby) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: rubyDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// CC_RUBY_VERSION
+			"ruby_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ruby version to use (default: the one defined in the Gemfile)",
+			},
+			// RAILS_ENV
+			"rails_env": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Rails environment (default: `production`)",
+			},
+			// CC_RAKEGOALS
+			"rake_goals": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comma separated list of rake goals to run before starting the application",
+			},
+			// CC_SIDEKIQ_FILES
+			"sidekiq_files": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comma separated list of Sidekiq configuration files, enables Sidekiq when set",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (rb *Ruby) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (rb *Ruby) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(rb.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(rb.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(rb.RubyVersion, func(s string) { env["CC_RUBY_VERSION"] = s })
+	pkg.IfIsSet(rb.RailsEnv, func(s string) { env["RAILS_ENV"] = s })
+	pkg.IfIsSet(rb.RakeGoals, func(s string) { env["CC_RAKEGOALS"] = s })
+	pkg.IfIsSet(rb.SidekiqFiles, func(s string) { env["CC_SIDEKIQ_FILES"] = s })
+	env = pkg.Merge(env, rb.Hooks.ToEnv())
+
+	return env
+}
+
+func (rb *Ruby) toDeployment() *application.Deployment {
+	if rb.Deployment == nil || rb.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: rb.Deployment.Repository.ValueString(),
+		Commit:     rb.Deployment.Commit.ValueStringPointer(),
+	}
+}
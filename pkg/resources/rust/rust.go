@@ -0,0 +1,22 @@
+package rust
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceRust struct {
+	cc          *client.Client
+	org         string
+	defaultTags []string
+}
+
+func NewResourceRust() resource.Resource {
+	return &ResourceRust{}
+}
+
+func (r *ResourceRust) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_rust"
+}
@@ -0,0 +1,79 @@
+package rust
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/application"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type Rust struct {
+	attributes.Runtime
+	Binary   types.String `tfsdk:"binary"`
+	Features types.String `tfsdk:"features"`
+}
+
+//go:embed doc.md
+var rustDoc string
+
+func (r ResourceRust) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: rustDoc,
+		Attributes: attributes.WithRuntimeCommons(map[string]schema.Attribute{
+			// CC_RUST_BIN
+			"binary": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Binary to run when the crate has several of them",
+			},
+			// CC_RUST_FEATURES
+			"features": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comma separated list of cargo features to enable during build",
+			},
+		}),
+		Blocks: attributes.WithBlockRuntimeCommons(map[string]schema.Block{}),
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (rs *Rust) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (rs *Rust) toEnv(ctx context.Context, diags diag.Diagnostics) map[string]string {
+	env := map[string]string{}
+
+	// do not use the real map since ElementAs can nullish it
+	// https://github.com/hashicorp/terraform-plugin-framework/issues/698
+	customEnv := map[string]string{}
+	diags.Append(rs.Environment.ElementsAs(ctx, &customEnv, false)...)
+	if diags.HasError() {
+		return env
+	}
+	env = pkg.Merge(env, customEnv)
+
+	pkg.IfIsSet(rs.AppFolder, func(s string) { env["APP_FOLDER"] = s })
+	pkg.IfIsSet(rs.Binary, func(s string) { env["CC_RUST_BIN"] = s })
+	pkg.IfIsSet(rs.Features, func(s string) { env["CC_RUST_FEATURES"] = s })
+	env = pkg.Merge(env, rs.Hooks.ToEnv())
+
+	return env
+}
+
+func (rs *Rust) toDeployment() *application.Deployment {
+	if rs.Deployment == nil || rs.Deployment.Repository.IsNull() {
+		return nil
+	}
+
+	return &application.Deployment{
+		Repository: rs.Deployment.Repository.ValueString(),
+		Commit:     rs.Deployment.Commit.ValueStringPointer(),
+	}
+}
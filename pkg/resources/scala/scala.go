@@ -8,8 +8,9 @@ import (
 )
 
 type ResourceScala struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceScala() func() resource.Resource {
@@ -8,8 +8,9 @@ import (
 )
 
 type ResourceStatic struct {
-	cc  *client.Client
-	org string
+	cc          *client.Client
+	org         string
+	defaultTags []string
 }
 
 func NewResourceStatic() func() resource.Resource {
@@ -0,0 +1,21 @@
+package tcpredirection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceTCPRedirection struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceTCPRedirection() resource.Resource {
+	return &ResourceTCPRedirection{}
+}
+
+func (r *ResourceTCPRedirection) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_tcp_redirection"
+}
@@ -0,0 +1,94 @@
+package tcpredirection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccTCPRedirection_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-tcp-%d", time.Now().UnixMilli())
+	fullName := fmt.Sprintf("clevercloud_tcp_redirection.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	dockerBlock := helper.NewRessource(
+		"clevercloud_docker",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 1,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "XS",
+			"container_port_tcp": 4040,
+		}))
+	redirectionBlock := helper.NewRessource(
+		"clevercloud_tcp_redirection",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"app_id":    fmt.Sprintf("${clevercloud_docker.%s.id}", rName),
+			"namespace": "cleverapps",
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(dockerBlock, redirectionBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*/cleverapps/[0-9]+$`)),
+				resource.TestCheckResourceAttrSet(fullName, "port"),
+			),
+		}, {
+			ResourceName:      fullName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				if resource.Type != "clevercloud_tcp_redirection" {
+					continue
+				}
+
+				res := tmp.GetTCPRedirections(ctx, cc, org, resource.Primary.Attributes["app_id"])
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				port := resource.Primary.Attributes["port"]
+				if pkg.HasSome(*res.Payload(), func(redirection tmp.TCPRedirection) bool { return fmt.Sprint(redirection.Port) == port }) {
+					return fmt.Errorf("expect TCP redirection '%s' to be deleted", resource.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
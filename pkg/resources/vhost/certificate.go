@@ -0,0 +1,50 @@
+package vhost
+
+import (
+	"strings"
+	"time"
+
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+const (
+	certificateValid   = "valid"
+	certificateExpired = "expired"
+	// no certificate uploaded for the domain, Clever Cloud provides one
+	certificateManaged = "managed"
+)
+
+// certificateStatus looks for an uploaded certificate covering the domain, a path prefix is ignored
+func certificateStatus(certificates []tmp.Certificate, fqdn string, now time.Time) string {
+	host, _, _ := strings.Cut(fqdn, "/")
+
+	status := certificateManaged
+	for _, certificate := range certificates {
+		if !covers(certificate.Domains, host) {
+			continue
+		}
+
+		notAfter, err := time.Parse(time.RFC3339, certificate.NotAfter)
+		if err == nil && now.After(notAfter) {
+			status = certificateExpired
+			continue
+		}
+
+		return certificateValid
+	}
+
+	return status
+}
+
+// a wildcard only covers one level of subdomain
+func covers(domains []string, host string) bool {
+	_, parent, _ := strings.Cut(host, ".")
+
+	for _, domain := range domains {
+		if strings.EqualFold(domain, host) || strings.EqualFold(domain, "*."+parent) {
+			return true
+		}
+	}
+
+	return false
+}
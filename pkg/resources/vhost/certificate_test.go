@@ -0,0 +1,39 @@
+package vhost
+
+import (
+	"testing"
+	"time"
+
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+func TestCertificateStatus(t *testing.T) {
+	now := time.Now()
+	valid := now.Add(30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	expired := now.Add(-time.Hour).UTC().Format(time.RFC3339)
+
+	certificates := []tmp.Certificate{
+		{ID: "cert_1", Domains: []string{"example.com", "www.example.com"}, NotAfter: valid},
+		{ID: "cert_2", Domains: []string{"*.apps.example.com"}, NotAfter: valid},
+		{ID: "cert_3", Domains: []string{"old.example.org"}, NotAfter: expired},
+		{ID: "cert_4", Domains: []string{"renewed.example.org"}, NotAfter: expired},
+		{ID: "cert_5", Domains: []string{"renewed.example.org"}, NotAfter: valid},
+	}
+
+	cases := map[string]string{
+		"example.com":          certificateValid,
+		"WWW.example.com/api":  certificateValid,
+		"a.apps.example.com":   certificateValid,
+		"a.b.apps.example.com": certificateManaged,
+		"apps.example.com":     certificateManaged,
+		"old.example.org":      certificateExpired,
+		"renewed.example.org":  certificateValid,
+		"other.example.net":    certificateManaged,
+	}
+
+	for fqdn, expected := range cases {
+		if status := certificateStatus(certificates, fqdn, now); status != expected {
+			t.Errorf("%s: expect %s, got %s", fqdn, expected, status)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package vhost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+	"go.clever-cloud.com/terraform-provider/pkg/provider"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+)
+
+// Weird behaviour, but TF can ask for a Resource without having configured a Provider (maybe for Meta and Schema)
+// So we need to handle the case there is no ProviderData
+func (r *ResourceVHost) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	tflog.Debug(ctx, "ResourceVHost.Configure()")
+
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(provider.Provider)
+	if ok {
+		r.cc = provider.Client()
+		r.org = provider.Organization()
+	}
+}
+
+// Create a new resource
+func (r *ResourceVHost) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	plan := VHost{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, attributes.DefaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	res := tmp.AddAppVHost(ctx, r.cc, r.org, plan.AppID.ValueString(), plan.FQDN.ValueString())
+	if res.HasError() {
+		resp.Diagnostics.AddError("failed to add vhost", res.Error().Error())
+		return
+	}
+
+	plan.ID = pkg.FromStr(vhostID(plan.AppID.ValueString(), plan.FQDN.ValueString()))
+	resp.Diagnostics.Append(r.refresh(ctx, &plan)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read resource information
+func (r *ResourceVHost) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "VHost READ", map[string]interface{}{"request": req})
+
+	var state VHost
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, attributes.DefaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	vhostsRes := tmp.GetAppVHosts(ctx, r.cc, r.org, state.AppID.ValueString())
+	if vhostsRes.IsNotFoundError() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if vhostsRes.HasError() {
+		resp.Diagnostics.AddError("failed to get vhosts", vhostsRes.Error().Error())
+		return
+	}
+
+	exists := pkg.HasSome(*vhostsRes.Payload(), func(vhost tmp.Vhost) bool {
+		return vhost.Fqdn == state.FQDN.ValueString()
+	})
+	if !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update resource
+func (r *ResourceVHost) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := VHost{}
+	state := VHost{}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// app_id and fqdn both require a replacement, only the timeouts can change
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Delete resource
+func (r *ResourceVHost) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state VHost
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, attributes.DefaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "VHOST DELETE", map[string]interface{}{"vhost": state})
+
+	res := tmp.DeleteAppVHost(ctx, r.cc, r.org, state.AppID.ValueString(), state.FQDN.ValueString())
+	if res.HasError() && !res.IsNotFoundError() {
+		resp.Diagnostics.AddError("failed to delete vhost", res.Error().Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Import resource
+func (r *ResourceVHost) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The fqdn can hold a path prefix, so only split on the first slash
+	appID, fqdn, ok := strings.Cut(req.ID, "/")
+	if !ok || appID == "" || fqdn == "" {
+		resp.Diagnostics.AddError("invalid import identifier", fmt.Sprintf("expect 'app_id/fqdn', got '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), fqdn)...)
+}
+
+// refresh computed attributes: DNS records to set up and certificate status
+func (r *ResourceVHost) refresh(ctx context.Context, vhost *VHost) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	lbRes := tmp.GetAppDefaultLoadBalancers(ctx, r.cc, r.org, vhost.AppID.ValueString())
+	if lbRes.HasError() {
+		diags.AddError("failed to get application load balancers", lbRes.Error().Error())
+		return diags
+	}
+
+	vhost.DNSCName = types.StringNull()
+	vhost.DNSARecords = types.ListNull(types.StringType)
+	if lbs := *lbRes.Payload(); len(lbs) > 0 {
+		vhost.DNSCName = pkg.FromStr(lbs[0].DNS.CName)
+		vhost.DNSARecords = pkg.FromListString(lbs[0].DNS.A)
+	}
+
+	certificatesRes := tmp.ListCertificates(ctx, r.cc, r.org)
+	if certificatesRes.HasError() {
+		diags.AddError("failed to list certificates", certificatesRes.Error().Error())
+		return diags
+	}
+
+	vhost.CertificateStatus = pkg.FromStr(certificateStatus(*certificatesRes.Payload(), vhost.FQDN.ValueString(), time.Now()))
+
+	return diags
+}
+
+func vhostID(appID, fqdn string) string {
+	return appID + "/" + fqdn
+}
@@ -0,0 +1,77 @@
+package vhost
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/attributes"
+)
+
+type VHost struct {
+	ID    types.String `tfsdk:"id"`
+	AppID types.String `tfsdk:"app_id"`
+	FQDN  types.String `tfsdk:"fqdn"`
+
+	DNSCName          types.String `tfsdk:"dns_cname"`
+	DNSARecords       types.List   `tfsdk:"dns_a_records"`
+	CertificateStatus types.String `tfsdk:"certificate_status"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+//go:embed doc.md
+var resourceVHostDoc string
+
+func (r ResourceVHost) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             0,
+		MarkdownDescription: resourceVHostDoc,
+		Attributes: map[string]schema.Attribute{
+			// customer provided
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Application to attach the domain to",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"fqdn": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Domain name, optionally followed by a path prefix (`example.com/api`)",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+
+			// provider provided
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier in the form `app_id/fqdn`",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dns_cname": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "CNAME record target to create for a subdomain",
+			},
+			"dns_a_records": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "A records to create for an apex domain",
+			},
+			"certificate_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Certificate of the domain: `valid` or `expired` for a certificate uploaded to the organisation, `managed` when Clever Cloud provides it",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": attributes.TimeoutsBlock,
+		},
+	}
+}
+
+// https://developer.hashicorp.com/terraform/plugin/framework/resources/state-upgrade#implementing-state-upgrade-support
+func (r ResourceVHost) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
@@ -0,0 +1,21 @@
+package vhost
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"go.clever-cloud.dev/client"
+)
+
+type ResourceVHost struct {
+	cc  *client.Client
+	org string
+}
+
+func NewResourceVHost() resource.Resource {
+	return &ResourceVHost{}
+}
+
+func (r *ResourceVHost) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_vhost"
+}
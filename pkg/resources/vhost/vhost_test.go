@@ -0,0 +1,95 @@
+package vhost_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.clever-cloud.com/terraform-provider/pkg"
+	"go.clever-cloud.com/terraform-provider/pkg/helper"
+	"go.clever-cloud.com/terraform-provider/pkg/provider/impl"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+var protoV6Provider = map[string]func() (tfprotov6.ProviderServer, error){
+	"clevercloud": providerserver.NewProtocol6WithError(impl.New("test")()),
+}
+
+func TestAccVHost_basic(t *testing.T) {
+	ctx := context.Background()
+	rName := fmt.Sprintf("tf-test-vhost-%d", time.Now().UnixMilli())
+	fqdn := fmt.Sprintf("%s.example.com", rName)
+	fullName := fmt.Sprintf("clevercloud_vhost.%s", rName)
+	cc := client.New(client.WithAutoOauthConfig())
+	org := os.Getenv("ORGANISATION")
+	providerBlock := helper.NewProvider("clevercloud").SetOrganisation(org)
+	phpBlock := helper.NewRessource(
+		"clevercloud_php",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"name":               rName,
+			"region":             "par",
+			"min_instance_count": 1,
+			"max_instance_count": 1,
+			"smallest_flavor":    "XS",
+			"biggest_flavor":     "XS",
+		}))
+	vhostBlock := helper.NewRessource(
+		"clevercloud_vhost",
+		rName,
+		helper.SetKeyValues(map[string]any{
+			"app_id": fmt.Sprintf("${clevercloud_php.%s.id}", rName),
+			"fqdn":   fqdn,
+		}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if org == "" {
+				t.Fatalf("missing ORGANISATION env var")
+			}
+		},
+		ProtoV6ProviderFactories: protoV6Provider,
+		Steps: []resource.TestStep{{
+			ResourceName: rName,
+			Config:       providerBlock.Append(phpBlock, vhostBlock).String(),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestMatchResourceAttr(fullName, "id", regexp.MustCompile(`^app_.*/`+regexp.QuoteMeta(fqdn)+`$`)),
+				resource.TestCheckResourceAttr(fullName, "fqdn", fqdn),
+				resource.TestCheckResourceAttrSet(fullName, "dns_cname"),
+				resource.TestCheckResourceAttr(fullName, "certificate_status", "managed"),
+			),
+		}, {
+			ResourceName:      fullName,
+			ImportState:       true,
+			ImportStateVerify: true,
+		}},
+		CheckDestroy: func(state *terraform.State) error {
+			for _, resource := range state.RootModule().Resources {
+				if resource.Type != "clevercloud_vhost" {
+					continue
+				}
+
+				res := tmp.GetAppVHosts(ctx, cc, org, resource.Primary.Attributes["app_id"])
+				if res.IsNotFoundError() {
+					continue
+				}
+				if res.HasError() {
+					return fmt.Errorf("unexpectd error: %s", res.Error().Error())
+				}
+
+				if pkg.HasSome(*res.Payload(), func(vhost tmp.Vhost) bool { return vhost.Fqdn == fqdn }) {
+					return fmt.Errorf("expect vhost '%s' to be deleted", resource.Primary.ID)
+				}
+			}
+			return nil
+		},
+	})
+}
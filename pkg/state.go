@@ -0,0 +1,27 @@
+package pkg
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// NullUnknowns replaces the values left unknown in the state by nulls.
+// Terraform refuses unknown values once applied, a resource saved after a partial failure may have some.
+func NullUnknowns(state *tfsdk.State) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+		return value, nil
+	})
+	if err != nil {
+		diags.AddError("failed to set unknown values to null", err.Error())
+		return diags
+	}
+
+	state.Raw = raw
+	return diags
+}
@@ -0,0 +1,60 @@
+package pkg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNullUnknowns(t *testing.T) {
+	ctx := context.Background()
+	appSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"name":       schema.StringAttribute{Required: true},
+		"deploy_url": schema.StringAttribute{Computed: true},
+		"tags_all":   schema.SetAttribute{Computed: true, ElementType: types.StringType},
+	}}
+
+	type app struct {
+		ID        types.String `tfsdk:"id"`
+		Name      types.String `tfsdk:"name"`
+		DeployURL types.String `tfsdk:"deploy_url"`
+		TagsAll   types.Set    `tfsdk:"tags_all"`
+	}
+
+	state := tfsdk.State{Schema: appSchema, Raw: tftypes.NewValue(appSchema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, app{
+		ID:        types.StringValue("app_1"),
+		Name:      types.StringValue("my-app"),
+		DeployURL: types.StringUnknown(),
+		TagsAll:   types.SetUnknown(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diags = NullUnknowns(&state)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	got := app{}
+	diags = state.Get(ctx, &got)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := app{
+		ID:        types.StringValue("app_1"),
+		Name:      types.StringValue("my-app"),
+		DeployURL: types.StringNull(),
+		TagsAll:   types.SetNull(types.StringType),
+	}
+	if !got.ID.Equal(expected.ID) || !got.Name.Equal(expected.Name) || !got.DeployURL.Equal(expected.DeployURL) || !got.TagsAll.Equal(expected.TagsAll) {
+		t.Errorf("expect %+v, got %+v", expected, got)
+	}
+}
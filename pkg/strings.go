@@ -20,6 +20,11 @@ func FromI(i int64) types.Int64 {
 	return types.Int64Value(i)
 }
 
+// Convert a native bool into a tfsdk one
+func FromBool(b bool) types.Bool {
+	return types.BoolValue(b)
+}
+
 // Convert a native int64 into a tfsdk one
 func FromListString(items []string) types.List {
 	return types.ListValueMust(
@@ -28,3 +33,12 @@ func FromListString(items []string) types.List {
 			return types.StringValue(item)
 		}))
 }
+
+// Convert a native string slice into a tfsdk set
+func FromSetString(items []string) types.Set {
+	return types.SetValueMust(
+		types.StringType,
+		Map(items, func(item string) attr.Value {
+			return types.StringValue(item)
+		}))
+}
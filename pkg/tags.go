@@ -0,0 +1,115 @@
+package pkg
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.clever-cloud.com/terraform-provider/pkg/tmp"
+	"go.clever-cloud.dev/client"
+)
+
+// Tags to apply on a resource: the configured ones plus the provider default ones
+func TagsWithDefaults(ctx context.Context, configured types.Set, defaults []string) ([]string, diag.Diagnostics) {
+	tags := []string{}
+	diags := configured.ElementsAs(ctx, &tags, false)
+
+	explicit := NewSet(tags...)
+	for _, tag := range defaults {
+		if !explicit.Contains(tag) {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags, diags
+}
+
+// Tags to save in state, provider default ones are hidden unless they are explicitly configured.
+// They are tracked in tags_all instead, see PlanTagsAll
+func TagsFromRemote(ctx context.Context, remote []string, configured types.Set, defaults []string) (types.Set, diag.Diagnostics) {
+	configuredTags := []string{}
+	diags := configured.ElementsAs(ctx, &configuredTags, false)
+
+	explicit := NewSet(configuredTags...)
+	implicit := NewSet(defaults...)
+	tags := Filter(remote, func(tag string) bool {
+		return explicit.Contains(tag) || !implicit.Contains(tag)
+	})
+
+	if len(tags) == 0 && configured.IsNull() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	sort.Strings(tags)
+	return FromSetString(tags), diags
+}
+
+// Plan tags_all, the configured tags merged with the provider default ones.
+// It differs from the state as soon as default_tags change, so the change is planned
+func PlanTagsAll(ctx context.Context, plan *tfsdk.Plan, defaults []string) diag.Diagnostics {
+	configured := types.SetNull(types.StringType)
+	diags := plan.GetAttribute(ctx, path.Root("tags"), &configured)
+	if diags.HasError() || configured.IsUnknown() {
+		return diags
+	}
+
+	tags, tagsDiags := TagsWithDefaults(ctx, configured, defaults)
+	diags.Append(tagsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(plan.SetAttribute(ctx, path.Root("tags_all"), FromSetString(tags))...)
+	return diags
+}
+
+// Tags to add and to remove to go from current to wanted
+func DiffTags(current, wanted []string) (toAdd, toRemove []string) {
+	currentSet := NewSet(current...)
+	wantedSet := NewSet(wanted...)
+
+	toAdd = Filter(wanted, func(tag string) bool { return !currentSet.Contains(tag) })
+	toRemove = Filter(current, func(tag string) bool { return !wantedSet.Contains(tag) })
+
+	return toAdd, toRemove
+}
+
+// Add and remove tags to go from current to wanted ones
+func SyncTags(current, wanted []string, add, remove func(tag string) error) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	toAdd, toRemove := DiffTags(current, wanted)
+	for _, tag := range toAdd {
+		if err := add(tag); err != nil {
+			diags.AddError(fmt.Sprintf("failed to add tag '%s'", tag), err.Error())
+		}
+	}
+	for _, tag := range toRemove {
+		if err := remove(tag); err != nil {
+			diags.AddError(fmt.Sprintf("failed to remove tag '%s'", tag), err.Error())
+		}
+	}
+
+	return diags
+}
+
+// Add and remove addon tags to match the wanted ones
+func SyncAddonTags(ctx context.Context, cc *client.Client, organisation, addonID string, tags []string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	tagsRes := tmp.GetAddonTags(ctx, cc, organisation, addonID)
+	if tagsRes.HasError() {
+		diags.AddError("failed to get addon tags", tagsRes.Error().Error())
+		return diags
+	}
+
+	return SyncTags(*tagsRes.Payload(), tags, func(tag string) error {
+		return tmp.AddAddonTag(ctx, cc, organisation, addonID, tag).Error()
+	}, func(tag string) error {
+		return tmp.DeleteAddonTag(ctx, cc, organisation, addonID, tag).Error()
+	})
+}
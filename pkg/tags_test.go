@@ -0,0 +1,77 @@
+package pkg
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDiffTags(t *testing.T) {
+	toAdd, toRemove := DiffTags([]string{"team:a", "env:prod"}, []string{"env:prod", "cost:42"})
+
+	if !reflect.DeepEqual(toAdd, []string{"cost:42"}) {
+		t.Errorf("expect to add 'cost:42', got %v", toAdd)
+	}
+	if !reflect.DeepEqual(toRemove, []string{"team:a"}) {
+		t.Errorf("expect to remove 'team:a', got %v", toRemove)
+	}
+}
+
+func TestTagsFromRemote(t *testing.T) {
+	ctx := context.Background()
+	configured := FromSetString([]string{"team:a", "env:prod"})
+
+	tags, diags := TagsFromRemote(ctx, []string{"team:a", "env:prod", "managed-by:terraform", "manual"}, configured, []string{"managed-by:terraform", "env:prod"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := FromSetString([]string{"env:prod", "manual", "team:a"})
+	if !tags.Equal(expected) {
+		t.Errorf("expect %s, got %s", expected, tags)
+	}
+
+	tags, _ = TagsFromRemote(ctx, []string{"managed-by:terraform"}, types.SetNull(types.StringType), []string{"managed-by:terraform"})
+	if !tags.IsNull() {
+		t.Errorf("expect null tags, got %s", tags)
+	}
+}
+
+func TestPlanTagsAll(t *testing.T) {
+	ctx := context.Background()
+	tagsSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"tags":     schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		"tags_all": schema.SetAttribute{Computed: true, ElementType: types.StringType},
+	}}
+
+	plan := tfsdk.Plan{Schema: tagsSchema, Raw: tftypes.NewValue(tagsSchema.Type().TerraformType(ctx), nil)}
+	diags := plan.Set(ctx, struct {
+		Tags    types.Set `tfsdk:"tags"`
+		TagsAll types.Set `tfsdk:"tags_all"`
+	}{
+		Tags:    FromSetString([]string{"team:a"}),
+		TagsAll: FromSetString([]string{"team:a"}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	diags = PlanTagsAll(ctx, &plan, []string{"managed-by:terraform"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	tagsAll := types.SetNull(types.StringType)
+	plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)
+
+	expected := FromSetString([]string{"team:a", "managed-by:terraform"})
+	if !tagsAll.Equal(expected) {
+		t.Errorf("expect %s, got %s", expected, tagsAll)
+	}
+}
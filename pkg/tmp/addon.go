@@ -46,19 +46,51 @@ type AddonProvider struct {
 type PostgreSQL struct {
 	// app_id:addon_5abaf3ea-d53f-4021-9711-cd294d50c662
 	// creation_date:2022-04-20T08:24:07.28Z[UTC]
-	Database string `json:"database" example:"bwf32ifhr5cofspgzrbb"`
-	// features:[map[enabled:false name:encryption]]
-	Host string `json:"host" example:"bwf32ifhr5cofspgzrbb-postgresql.services.clever-cloud.com"`
+	Database string         `json:"database" example:"bwf32ifhr5cofspgzrbb"`
+	Features []AddonFeature `json:"features"`
+	Host     string         `json:"host" example:"bwf32ifhr5cofspgzrbb-postgresql.services.clever-cloud.com"`
 	// id:ea97919f-983b-4699-a673-2ed0668bf196
 	// owner_id:user_32114ae3-1716-4aa7-8d16-e664ca6ccd1f
 	Password string `json:"password" example:"omEbGQw628gIxHK9Bp8d"`
 	Plan     string `json:"plan" example:"xs_med"`
 	Port     int    `json:"port" example:"6388"`
 	// read_only_users:[]
-	Status string `json:"status" example:"ACTIVE"`
-	User   string `json:"user" example:"uxw1ikwnp6gflbgp5iun"`
-	// version:14
-	Zone string `json:"zone" example:"par"`
+	Status  string `json:"status" example:"ACTIVE"`
+	User    string `json:"user" example:"uxw1ikwnp6gflbgp5iun"`
+	Version string `json:"version" example:"14"`
+	Zone    string `json:"zone" example:"par"`
+}
+
+type AddonFeature struct {
+	Name    string `json:"name" example:"encryption"`
+	Enabled bool   `json:"enabled"`
+}
+
+// Options an addon provider accepts at creation, by version
+type AddonProviderInfo struct {
+	ProviderID              string                          `json:"providerId"`
+	Dedicated               map[string]AddonProviderVersion `json:"dedicated"`
+	DefaultDedicatedVersion string                          `json:"defaultDedicatedVersion"`
+}
+
+type AddonProviderVersion struct {
+	Features []AddonFeature `json:"features"`
+}
+
+// Version and features of an existing addon, exposed by the providers supporting options
+type AddonOptions struct {
+	Version  string         `json:"version"`
+	Features []AddonFeature `json:"features"`
+}
+
+func GetAddonProviderInfo(ctx context.Context, cc *client.Client, providerID string) client.Response[AddonProviderInfo] {
+	path := fmt.Sprintf("/v4/addon-providers/%s", providerID)
+	return client.Get[AddonProviderInfo](ctx, cc, path)
+}
+
+func GetAddonOptions(ctx context.Context, cc *client.Client, providerID, addonID string) client.Response[AddonOptions] {
+	path := fmt.Sprintf("/v4/addon-providers/%s/addons/%s", providerID, addonID)
+	return client.Get[AddonOptions](ctx, cc, path)
 }
 
 func GetAddonsProviders(ctx context.Context, cc *client.Client) client.Response[[]AddonProvider] {
@@ -75,6 +107,57 @@ func GetPostgreSQL(ctx context.Context, cc *client.Client, postgresqlID string)
 	return client.Get[PostgreSQL](ctx, cc, path)
 }
 
+type MySQL struct {
+	Database string         `json:"database" example:"bq5e1tmxuwydopzdtybg"`
+	Features []AddonFeature `json:"features"`
+	Host     string         `json:"host" example:"bq5e1tmxuwydopzdtybg-mysql.services.clever-cloud.com"`
+	Password string         `json:"password" example:"Sgf4Dlj0iPBPqcmSa8tC"`
+	Plan     string         `json:"plan" example:"xs_sml"`
+	Port     int            `json:"port" example:"3306"`
+	Status   string         `json:"status" example:"ACTIVE"`
+	User     string         `json:"user" example:"uqnfmj3vpa6pmqaq"`
+	Version  string         `json:"version" example:"8.0"`
+	Zone     string         `json:"zone" example:"par"`
+}
+
+func GetMySQL(ctx context.Context, cc *client.Client, mysqlID string) client.Response[MySQL] {
+	path := fmt.Sprintf("/v4/addon-providers/mysql-addon/addons/%s", mysqlID)
+	return client.Get[MySQL](ctx, cc, path)
+}
+
+type Redis struct {
+	Host     string `json:"host" example:"bxzgojtuctdytqkqspyx-redis.services.clever-cloud.com"`
+	Password string `json:"password" example:"4Ch1Hp7xRZtMXjqyJtjd"`
+	Plan     string `json:"plan" example:"s_mono"`
+	Port     int    `json:"port" example:"3050"`
+	Status   string `json:"status" example:"ACTIVE"`
+	Version  string `json:"version" example:"7.2.4"`
+	Zone     string `json:"zone" example:"par"`
+}
+
+func GetRedis(ctx context.Context, cc *client.Client, redisID string) client.Response[Redis] {
+	path := fmt.Sprintf("/v4/addon-providers/redis-addon/addons/%s", redisID)
+	return client.Get[Redis](ctx, cc, path)
+}
+
+type Elasticsearch struct {
+	APMApplication    string         `json:"apm_application" example:"app_b9ab4a1b-4a3d-4f2b-9e3e-0c6e2a5c2a41"`
+	Host              string         `json:"host" example:"bqkdaxn2ttz4wmazwqor-elasticsearch.services.clever-cloud.com"`
+	KibanaApplication string         `json:"kibana_application" example:"app_0c5e5a27-8b1c-4b0e-a0c6-39d6de6a1c7f"`
+	Password          string         `json:"password" example:"2wLtnCrSvTqGmPo1xeRf"`
+	Plan              string         `json:"plan" example:"xs"`
+	Services          []AddonFeature `json:"services"`
+	Status            string         `json:"status" example:"ACTIVE"`
+	User              string         `json:"user" example:"uz5iq1k3ovdf3atffxwh"`
+	Version           string         `json:"version" example:"8"`
+	Zone              string         `json:"zone" example:"par"`
+}
+
+func GetElasticsearch(ctx context.Context, cc *client.Client, elasticsearchID string) client.Response[Elasticsearch] {
+	path := fmt.Sprintf("/v4/addon-providers/es-addon/addons/%s", elasticsearchID)
+	return client.Get[Elasticsearch](ctx, cc, path)
+}
+
 type MateriaKV struct {
 	ID             string `json:"id"`
 	ClusterID      string `json:"clusterId"`
@@ -120,11 +203,13 @@ func GetMetabase(ctx context.Context, cc *client.Client, metabaseID string) clie
 }
 
 type MongoDB struct {
-	Host     string `json:"host"`
-	Port     int64  `json:"port"`
-	Status   string `json:"status" example:"ACTIVE"`
-	User     string `tfsdk:"user"`
-	Password string `tfsdk:"password"`
+	Host     string         `json:"host"`
+	Port     int64          `json:"port"`
+	Status   string         `json:"status" example:"ACTIVE"`
+	User     string         `json:"user"`
+	Password string         `json:"password"`
+	Version  string         `json:"version" example:"4.0.3"`
+	Features []AddonFeature `json:"features"`
 }
 
 func GetMongoDB(ctx context.Context, cc *client.Client, mongodbID string) client.Response[MongoDB] {
@@ -178,3 +263,44 @@ func GetAddonEnv(ctx context.Context, cc *client.Client, organisation string, ad
 	path := fmt.Sprintf("/v2/organisations/%s/addons/%s/env", organisation, addon)
 	return client.Get[[]EnvVar](ctx, cc, path)
 }
+
+func ListAddons(ctx context.Context, cc *client.Client, organisation string) client.Response[[]AddonResponse] {
+	path := fmt.Sprintf("/v2/organisations/%s/addons", organisation)
+	return client.Get[[]AddonResponse](ctx, cc, path)
+}
+
+type AddonUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+func UpdateAddon(ctx context.Context, cc *client.Client, organisation string, addon string, req AddonUpdateRequest) client.Response[AddonResponse] {
+	path := fmt.Sprintf("/v2/organisations/%s/addons/%s", organisation, addon)
+	return client.Put[AddonResponse](ctx, cc, path, req)
+}
+
+const (
+	AddonMigrationRunning = "RUNNING"
+	AddonMigrationOK      = "OK"
+	AddonMigrationFailed  = "FAILED"
+)
+
+type AddonMigrationRequest struct {
+	PlanID string `json:"planId"`
+	Region string `json:"region"`
+}
+
+type AddonMigration struct {
+	ID     string `json:"migrationId"`
+	Status string `json:"status" example:"RUNNING"`
+	// steps:[map[status:OK value:PREPARE] ...]
+}
+
+func MigrateAddon(ctx context.Context, cc *client.Client, providerID, addonID string, req AddonMigrationRequest) client.Response[AddonMigration] {
+	path := fmt.Sprintf("/v4/addon-providers/%s/addons/%s/migrations", providerID, addonID)
+	return client.Post[AddonMigration](ctx, cc, path, req)
+}
+
+func GetAddonMigration(ctx context.Context, cc *client.Client, providerID, addonID, migrationID string) client.Response[AddonMigration] {
+	path := fmt.Sprintf("/v4/addon-providers/%s/addons/%s/migrations/%s", providerID, addonID, migrationID)
+	return client.Get[AddonMigration](ctx, cc, path)
+}
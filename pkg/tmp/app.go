@@ -3,6 +3,7 @@ package tmp
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"go.clever-cloud.dev/client"
 )
@@ -21,6 +22,8 @@ type CreateAppRequest struct {
 	MaxInstances    int64  `json:"maxInstances" example:"4"`
 	Zone            string `json:"zone" example:"par"`
 	CancelOnPush    bool   `json:"cancelOnPush"`
+	Homogeneous     bool   `json:"homogeneous"`
+	SeparateBuild   bool   `json:"separateBuild"`
 	StickySessions  bool   `json:"stickySessions"`
 	ForceHttps      string `json:"forceHttps"`
 }
@@ -226,6 +229,8 @@ type UpdateAppReq struct {
 	MaxInstances    int64  `json:"maxInstances" example:"4"`
 	Zone            string `json:"zone" example:"par"`
 	CancelOnPush    bool   `json:"cancelOnPush"`
+	Homogeneous     bool   `json:"homogeneous"`
+	SeparateBuild   bool   `json:"separateBuild"`
 	StickySessions  bool   `json:"stickySessions"`
 	ForceHttps      string `json:"forceHttps"`
 }
@@ -236,7 +241,7 @@ func UpdateApp(ctx context.Context, cc *client.Client, organisationID, applicati
 }
 
 func AddAppVHost(ctx context.Context, cc *client.Client, organisationID, applicationID, vhost string) client.Response[interface{}] {
-	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/vhosts/%s", organisationID, applicationID, vhost)
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/vhosts/%s", organisationID, applicationID, url.PathEscape(vhost))
 	return client.Put[interface{}](ctx, cc, path, map[string]string{})
 }
 
@@ -249,3 +254,43 @@ func GetAppLinkedAddons(ctx context.Context, cc *client.Client, organisationID,
 	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/addons", organisationID, applicationID)
 	return client.Get[[]AddonResponse](ctx, cc, path)
 }
+
+func GetAppVHosts(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[[]Vhost] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/vhosts", organisationID, applicationID)
+	return client.Get[[]Vhost](ctx, cc, path)
+}
+
+func DeleteAppVHost(ctx context.Context, cc *client.Client, organisationID, applicationID, vhost string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/vhosts/%s", organisationID, applicationID, url.PathEscape(vhost))
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
+
+type LoadBalancer struct {
+	ID     string          `json:"id"`
+	Name   string          `json:"name"`
+	Zone   string          `json:"zone"`
+	ZoneID string          `json:"zoneId"`
+	DNS    LoadBalancerDNS `json:"dns"`
+}
+
+type LoadBalancerDNS struct {
+	CName string   `json:"cname"`
+	A     []string `json:"a"`
+}
+
+// GetAppDefaultLoadBalancers returns the load balancers serving the application's vhosts
+func GetAppDefaultLoadBalancers(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[[]LoadBalancer] {
+	path := fmt.Sprintf("/v4/load-balancers/organisations/%s/applications/%s/load-balancers/default", organisationID, applicationID)
+	return client.Get[[]LoadBalancer](ctx, cc, path)
+}
+
+// StartApp starts a stopped application, redeploying its last commit
+func StartApp(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/instances", organisationID, applicationID)
+	return client.Post[client.Nothing](ctx, cc, path, map[string]string{})
+}
+
+func StopApp(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/instances", organisationID, applicationID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
@@ -0,0 +1,42 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+
+	"go.clever-cloud.dev/client"
+)
+
+type CertificateRequest struct {
+	// certificate chain followed by the private key, PEM encoded
+	PEM string `json:"pem"`
+}
+
+type Certificate struct {
+	ID        string   `json:"id"`
+	CN        string   `json:"cn"`
+	Domains   []string `json:"domains"`
+	Issuer    string   `json:"issuer"`
+	NotAfter  string   `json:"notAfter"`
+	NotBefore string   `json:"notBefore"`
+}
+
+func CreateCertificate(ctx context.Context, cc *client.Client, organisationID string, req CertificateRequest) client.Response[Certificate] {
+	path := fmt.Sprintf("/v4/certificates/organisations/%s/certificates", organisationID)
+	return client.Post[Certificate](ctx, cc, path, req)
+}
+
+func ListCertificates(ctx context.Context, cc *client.Client, organisationID string) client.Response[[]Certificate] {
+	path := fmt.Sprintf("/v4/certificates/organisations/%s/certificates", organisationID)
+	return client.Get[[]Certificate](ctx, cc, path)
+}
+
+func GetCertificate(ctx context.Context, cc *client.Client, organisationID, certificateID string) client.Response[Certificate] {
+	path := fmt.Sprintf("/v4/certificates/organisations/%s/certificates/%s", organisationID, certificateID)
+	return client.Get[Certificate](ctx, cc, path)
+}
+
+func DeleteCertificate(ctx context.Context, cc *client.Client, organisationID, certificateID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/certificates/organisations/%s/certificates/%s", organisationID, certificateID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
@@ -0,0 +1,58 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+
+	"go.clever-cloud.dev/client"
+)
+
+type DrainCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+type DrainRequest struct {
+	URL                      string            `json:"url"`
+	DrainType                string            `json:"drainType"`
+	Credentials              *DrainCredentials `json:"credentials,omitempty"`
+	APIKey                   string            `json:"apiKey,omitempty"`
+	IndexPrefix              string            `json:"indexPrefix,omitempty"`
+	StructuredDataParameters string            `json:"structuredDataParameters,omitempty"`
+}
+
+type Drain struct {
+	ID                       string           `json:"id"`
+	AppID                    string           `json:"appId"`
+	URL                      string           `json:"url"`
+	State                    string           `json:"state"` // ENABLED, DISABLED
+	DrainType                string           `json:"drainType"`
+	Credentials              DrainCredentials `json:"credentials"`
+	IndexPrefix              string           `json:"indexPrefix"`
+	StructuredDataParameters string           `json:"structuredDataParameters"`
+}
+
+type DrainStateRequest struct {
+	State string `json:"state"`
+}
+
+// resourceID is either an application or an addon ID
+func ListDrains(ctx context.Context, cc *client.Client, resourceID string) client.Response[[]Drain] {
+	path := fmt.Sprintf("/v2/logs/%s/drains", resourceID)
+	return client.Get[[]Drain](ctx, cc, path)
+}
+
+func CreateDrain(ctx context.Context, cc *client.Client, resourceID string, req DrainRequest) client.Response[Drain] {
+	path := fmt.Sprintf("/v2/logs/%s/drains", resourceID)
+	return client.Post[Drain](ctx, cc, path, req)
+}
+
+func UpdateDrainState(ctx context.Context, cc *client.Client, resourceID, drainID, state string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/logs/%s/drains/%s/state", resourceID, drainID)
+	return client.Put[client.Nothing](ctx, cc, path, DrainStateRequest{State: state})
+}
+
+func DeleteDrain(ctx context.Context, cc *client.Client, resourceID, drainID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/logs/%s/drains/%s", resourceID, drainID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
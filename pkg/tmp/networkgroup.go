@@ -0,0 +1,117 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+
+	"go.clever-cloud.dev/client"
+)
+
+type NetworkgroupRequest struct {
+	ID          string   `json:"id"`
+	OwnerID     string   `json:"ownerId"`
+	Label       string   `json:"label"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags"`
+}
+
+type Networkgroup struct {
+	ID          string               `json:"id"`
+	OwnerID     string               `json:"ownerId"`
+	Label       string               `json:"label"`
+	Description string               `json:"description"`
+	NetworkIP   string               `json:"networkIp"`
+	Tags        []string             `json:"tags"`
+	Members     []NetworkgroupMember `json:"members"`
+	Peers       []NetworkgroupPeer   `json:"peers"`
+}
+
+const (
+	NetworkgroupMemberApplication = "APPLICATION"
+	NetworkgroupMemberAddon       = "ADDON"
+	NetworkgroupMemberExternal    = "EXTERNAL"
+)
+
+type NetworkgroupMember struct {
+	ID         string `json:"id"`
+	Label      string `json:"label"`
+	DomainName string `json:"domainName"`
+	Kind       string `json:"kind"` // APPLICATION, ADDON, EXTERNAL
+}
+
+type NetworkgroupPeer struct {
+	ID           string `json:"id"`
+	Label        string `json:"label"`
+	PublicKey    string `json:"publicKey"`
+	Hostname     string `json:"hostname"`
+	ParentMember string `json:"parentMember"`
+	Type         string `json:"type"` // CleverPeer, ExternalPeer
+}
+
+type NetworkgroupExternalPeerRequest struct {
+	PeerRole     string `json:"peerRole"` // CLIENT, SERVER
+	PublicKey    string `json:"publicKey"`
+	Label        string `json:"label"`
+	ParentMember string `json:"parentMember"`
+}
+
+type NetworkgroupExternalPeerResponse struct {
+	PeerID string `json:"peerId"`
+}
+
+type NetworkgroupWireguardConfiguration struct {
+	NetworkgroupID string `json:"ngId"`
+	PeerID         string `json:"peerId"`
+	// base64 encoded wg-quick configuration, without the private key
+	Configuration string `json:"configuration"`
+}
+
+func CreateNetworkgroup(ctx context.Context, cc *client.Client, organisationID string, req NetworkgroupRequest) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups", organisationID)
+	return client.Post[client.Nothing](ctx, cc, path, req)
+}
+
+func GetNetworkgroup(ctx context.Context, cc *client.Client, organisationID, networkgroupID string) client.Response[Networkgroup] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s", organisationID, networkgroupID)
+	return client.Get[Networkgroup](ctx, cc, path)
+}
+
+func DeleteNetworkgroup(ctx context.Context, cc *client.Client, organisationID, networkgroupID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s", organisationID, networkgroupID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
+
+func AddNetworkgroupMember(ctx context.Context, cc *client.Client, organisationID, networkgroupID string, member NetworkgroupMember) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/members", organisationID, networkgroupID)
+	return client.Post[client.Nothing](ctx, cc, path, member)
+}
+
+func GetNetworkgroupMember(ctx context.Context, cc *client.Client, organisationID, networkgroupID, memberID string) client.Response[NetworkgroupMember] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/members/%s", organisationID, networkgroupID, memberID)
+	return client.Get[NetworkgroupMember](ctx, cc, path)
+}
+
+func DeleteNetworkgroupMember(ctx context.Context, cc *client.Client, organisationID, networkgroupID, memberID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/members/%s", organisationID, networkgroupID, memberID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
+
+func AddNetworkgroupExternalPeer(ctx context.Context, cc *client.Client, organisationID, networkgroupID string, peer NetworkgroupExternalPeerRequest) client.Response[NetworkgroupExternalPeerResponse] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/external-peers", organisationID, networkgroupID)
+	return client.Post[NetworkgroupExternalPeerResponse](ctx, cc, path, peer)
+}
+
+func GetNetworkgroupPeer(ctx context.Context, cc *client.Client, organisationID, networkgroupID, peerID string) client.Response[NetworkgroupPeer] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/peers/%s", organisationID, networkgroupID, peerID)
+	return client.Get[NetworkgroupPeer](ctx, cc, path)
+}
+
+func DeleteNetworkgroupExternalPeer(ctx context.Context, cc *client.Client, organisationID, networkgroupID, peerID string) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/external-peers/%s", organisationID, networkgroupID, peerID)
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
+
+func GetNetworkgroupPeerWireguardConfiguration(ctx context.Context, cc *client.Client, organisationID, networkgroupID, peerID string) client.Response[NetworkgroupWireguardConfiguration] {
+	path := fmt.Sprintf("/v4/networkgroups/organisations/%s/networkgroups/%s/peers/%s/wireguard/configuration", organisationID, networkgroupID, peerID)
+	return client.Get[NetworkgroupWireguardConfiguration](ctx, cc, path)
+}
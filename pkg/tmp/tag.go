@@ -0,0 +1,39 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.clever-cloud.dev/client"
+)
+
+func GetAppTags(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tags", organisationID, applicationID)
+	return client.Get[[]string](ctx, cc, path)
+}
+
+func AddAppTag(ctx context.Context, cc *client.Client, organisationID, applicationID, tag string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tags/%s", organisationID, applicationID, url.PathEscape(tag))
+	return client.Put[[]string](ctx, cc, path, map[string]string{})
+}
+
+func DeleteAppTag(ctx context.Context, cc *client.Client, organisationID, applicationID, tag string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tags/%s", organisationID, applicationID, url.PathEscape(tag))
+	return client.Delete[[]string](ctx, cc, path)
+}
+
+func GetAddonTags(ctx context.Context, cc *client.Client, organisationID, addonID string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/addons/%s/tags", organisationID, addonID)
+	return client.Get[[]string](ctx, cc, path)
+}
+
+func AddAddonTag(ctx context.Context, cc *client.Client, organisationID, addonID, tag string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/addons/%s/tags/%s", organisationID, addonID, url.PathEscape(tag))
+	return client.Put[[]string](ctx, cc, path, map[string]string{})
+}
+
+func DeleteAddonTag(ctx context.Context, cc *client.Client, organisationID, addonID, tag string) client.Response[[]string] {
+	path := fmt.Sprintf("/v2/organisations/%s/addons/%s/tags/%s", organisationID, addonID, url.PathEscape(tag))
+	return client.Delete[[]string](ctx, cc, path)
+}
@@ -0,0 +1,33 @@
+package tmp
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"go.clever-cloud.dev/client"
+)
+
+type TCPRedirection struct {
+	Namespace string `json:"namespace"`
+	Port      int64  `json:"port"`
+}
+
+type TCPRedirectionRequest struct {
+	Namespace string `json:"namespace"`
+}
+
+func GetTCPRedirections(ctx context.Context, cc *client.Client, organisationID, applicationID string) client.Response[[]TCPRedirection] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tcpRedirs", organisationID, applicationID)
+	return client.Get[[]TCPRedirection](ctx, cc, path)
+}
+
+func AddTCPRedirection(ctx context.Context, cc *client.Client, organisationID, applicationID, namespace string) client.Response[TCPRedirection] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tcpRedirs", organisationID, applicationID)
+	return client.Post[TCPRedirection](ctx, cc, path, TCPRedirectionRequest{Namespace: namespace})
+}
+
+func DeleteTCPRedirection(ctx context.Context, cc *client.Client, organisationID, applicationID, namespace string, port int64) client.Response[client.Nothing] {
+	path := fmt.Sprintf("/v2/organisations/%s/applications/%s/tcpRedirs/%d?namespace=%s", organisationID, applicationID, port, url.QueryEscape(namespace))
+	return client.Delete[client.Nothing](ctx, cc, path)
+}
@@ -0,0 +1,23 @@
+package tmp
+
+import (
+	"context"
+
+	"go.clever-cloud.dev/client"
+)
+
+type Zone struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Country     string   `json:"country"`
+	CountryCode string   `json:"countryCode"`
+	City        string   `json:"city"`
+	DisplayName string   `json:"displayName"`
+	Lat         float64  `json:"lat"`
+	Lon         float64  `json:"lon"`
+	Tags        []string `json:"tags"`
+}
+
+func GetZones(ctx context.Context, cc *client.Client) client.Response[[]Zone] {
+	return client.Get[[]Zone](ctx, cc, "/v4/products/zones")
+}
@@ -0,0 +1,52 @@
+package pkg
+
+import (
+	"context"
+	"time"
+)
+
+// WaitFor calls fn every interval until it reports done, fails or the context expires
+func WaitFor(ctx context.Context, interval time.Duration, fn func() (bool, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitWithBackoff calls fn until it reports done, fails or the context expires,
+// the delay between two calls doubles from initial up to max
+func WaitWithBackoff(ctx context.Context, initial, max time.Duration, fn func() (bool, error)) error {
+	delay := initial
+
+	for {
+		done, err := fn()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+
+		delay = min(2*delay, max)
+	}
+}
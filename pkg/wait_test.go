@@ -0,0 +1,36 @@
+package pkg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitWithBackoff(t *testing.T) {
+	ctx := context.Background()
+
+	calls := 0
+	err := WaitWithBackoff(ctx, time.Millisecond, 4*time.Millisecond, func() (bool, error) {
+		calls++
+		return calls == 5, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 5 {
+		t.Errorf("expect 5 calls, got %d", calls)
+	}
+}
+
+func TestWaitWithBackoff_timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := WaitWithBackoff(ctx, time.Millisecond, 2*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expect deadline exceeded, got %v", err)
+	}
+}